@@ -8,8 +8,10 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	oraclekeeper "github.com/irisnet/irismod/modules/oracle/keeper"
-	oracletypes "github.com/irisnet/irismod/modules/oracle/types"
 	tokenkeeper "github.com/irisnet/irismod/modules/token/keeper"
+
+	guardiankeeper "github.com/irisnet/irishub/modules/guardian/keeper"
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 )
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -20,7 +22,7 @@ func NewAnteHandler(
 	bk bankkeeper.Keeper,
 	tk tokenkeeper.Keeper,
 	ok oraclekeeper.Keeper,
-	oak oracletypes.AuthKeeper,
+	gk guardiankeeper.Keeper,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
@@ -29,6 +31,8 @@ func NewAnteHandler(
 		ante.NewRejectExtensionOptionsDecorator(),
 		ante.NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
+		guardiankeeper.NewCircuitBreakerDecorator(gk),
+		guardiankeeper.NewBlocklistDecorator(gk),
 		ante.TxTimeoutHeightDecorator{},
 		ante.NewValidateMemoDecorator(ak),
 		ante.NewConsumeGasForTxSizeDecorator(ak),
@@ -41,6 +45,6 @@ func NewAnteHandler(
 		ante.NewIncrementSequenceDecorator(ak),
 		NewCheckTokenDecorator(tk),
 		tokenkeeper.NewValidateTokenFeeDecorator(tk, bk),
-		oraclekeeper.NewValidateOracleAuthDecorator(ok, oak),
+		oraclekeeper.NewValidateOracleAuthDecorator(ok, gk.ForScope(guardiantypes.ScopeOracle)),
 	)
 }
@@ -8,10 +8,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 )
 
 func TestIrisAppExport(t *testing.T) {
@@ -19,6 +23,15 @@ func TestIrisAppExport(t *testing.T) {
 	app := NewIrisApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, simapp.FlagPeriodValue, MakeEncodingConfig(), EmptyAppOptions{}, interBlockCacheOpt())
 
 	genesisState := NewDefaultGenesisState()
+
+	// the guardian genesis requires a genesis super
+	superAddr := sdk.AccAddress(crypto.AddressHash([]byte("genesis_super")))
+	guardianGenState := guardiantypes.DefaultGenesisState()
+	guardianGenState.Supers = []guardiantypes.Super{
+		guardiantypes.NewSuper("genesis", guardiantypes.Genesis, superAddr, superAddr),
+	}
+	genesisState[guardiantypes.ModuleName] = app.AppCodec().MustMarshalJSON(guardianGenState)
+
 	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
 	require.NoError(t, err)
 
@@ -0,0 +1,23 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// UpgradeName is the name of the software upgrade plan which migrates a running chain
+// to the current store layout
+const UpgradeName = "v1.1"
+
+// registerUpgradeHandlers registers the store migrations applied when the upgrade plan is executed
+func (app *IrisApp) registerUpgradeHandlers() {
+	app.upgradeKeeper.SetUpgradeHandler(
+		UpgradeName,
+		func(ctx sdk.Context, plan upgradetypes.Plan) {
+			app.mintKeeper.MigrateParams(ctx)
+			app.mintKeeper.EnsureSupplyBaseline(ctx)
+			app.guardianKeeper.MigrateParams(ctx)
+			app.guardianKeeper.MigrateScopes(ctx)
+		},
+	)
+}
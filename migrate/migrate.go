@@ -520,16 +520,28 @@ func migrateMint(initialState v0_16.GenesisFileState) *minttypes.GenesisState {
 	minter := minttypes.Minter{
 		LastUpdate:    initialState.MintData.Minter.LastUpdate,
 		InflationBase: initialState.MintData.Minter.InflationBase.Quo(Precision),
-	}
-	params := minttypes.Params{
-		Inflation: initialState.MintData.Params.Inflation,
-		MintDenom: UIRIS,
-	}
+		Inflation:     initialState.MintData.Params.Inflation,
+		LastRebase:    initialState.MintData.Minter.LastUpdate,
+	}
+	defaultParams := minttypes.DefaultParams()
+	params := minttypes.NewParams(
+		UIRIS,
+		initialState.MintData.Params.Inflation,
+		defaultParams.ProvisionMode,
+		defaultParams.MaxBlockDuration,
+		defaultParams.InflationSchedule,
+		defaultParams.InflationMode,
+		defaultParams.InflationRateChange,
+		defaultParams.InflationMax,
+		defaultParams.InflationMin,
+		defaultParams.GoalBonded,
+		defaultParams.Recipients,
+		defaultParams.MaxSupply,
+		defaultParams.RebasePeriod,
+		defaultParams.CheckpointInterval,
+	)
 
-	return &minttypes.GenesisState{
-		Minter: minter,
-		Params: params,
-	}
+	return minttypes.NewGenesisState(minter, params, minttypes.DefaultTotalMinted(), nil)
 }
 
 func migrateRand(initialState v0_16.GenesisFileState) *randomtypes.GenesisState {
@@ -594,17 +606,32 @@ func migrateGuardian(initialState v0_16.GenesisFileState) *guardiantypes.Genesis
 		if err != nil {
 			panic(err.Error())
 		}
-		supers = append(supers, guardiantypes.Super{
+		super := guardiantypes.Super{
 			Description: profiler.Description,
 			AccountType: accountType,
 			Address:     profiler.Address.String(),
 			AddedBy:     profiler.AddedBy.String(),
-		})
+		}
+		// profilers were authorized for every privileged operation of v0.16 before scopes existed,
+		// the powers introduced since then have to be granted explicitly
+		if accountType == guardiantypes.Ordinary {
+			super.Scopes = []guardiantypes.Scope{guardiantypes.ScopeOracle}
+		}
+		supers = append(supers, super)
 	}
 
-	return &guardiantypes.GenesisState{
-		Supers: supers,
+	var trustees []guardiantypes.Trustee
+	for _, trustee := range initialState.GuardianData.Trustees {
+		accountType, err := guardiantypes.AccountTypeFromString(trustee.AccountType.String())
+		if err != nil {
+			panic(err.Error())
+		}
+		trustees = append(trustees, guardiantypes.NewTrustee(
+			trustee.Description, accountType, trustee.Address, trustee.AddedBy,
+		))
 	}
+
+	return guardiantypes.NewGenesisState(supers, guardiantypes.DefaultParams(), nil, 1, nil, trustees, nil, nil)
 }
 
 func migrateService(initialState v0_16.GenesisFileState) *servicetypes.GenesisState {
@@ -0,0 +1,19 @@
+package guardian
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+)
+
+// EndBlocker handles block ending logic for guardian
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// remove the supers whose expiration time has passed
+	k.PruneExpiredSupers(ctx)
+
+	// prune the membership proposals which were not executed in time
+	k.PruneExpiredMembershipProposals(ctx)
+
+	// enable the paused message types whose expiry height has been reached
+	k.PruneExpiredPausedMsgs(ctx)
+}
@@ -43,7 +43,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 
 	var guardianGenState guardiantypes.GenesisState
 	cfg.Codec.MustUnmarshalJSON(cfg.GenesisState[guardiantypes.ModuleName], &guardianGenState)
-	guardianGenState.Supers = append(guardianGenState.Supers, guardian)
+	guardianGenState.Supers = []guardiantypes.Super{guardian}
 
 	cfg.GenesisState[guardiantypes.ModuleName] = cfg.Codec.MustMarshalJSON(&guardianGenState)
 
@@ -116,6 +116,23 @@ func (s *IntegrationTestSuite) TestGuardian() {
 	supersResp = respType.(*guardiantypes.QuerySupersResponse)
 	s.Require().Equal(2, len(supersResp.Supers))
 
+	respType = proto.Message(&guardiantypes.QuerySupersResponse{})
+	bz, err = guardiantestutil.QuerySupersExec(clientCtx, fmt.Sprintf("--%s=%s", guardiancli.FlagAccountType, guardiantypes.Ordinary))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType))
+	supersResp = respType.(*guardiantypes.QuerySupersResponse)
+	s.Require().Equal(1, len(supersResp.Supers))
+	s.Require().Equal(from.String(), supersResp.Supers[0].Address)
+
+	//------test GetCmdQuerySuper()-------------
+	respType = proto.Message(&guardiantypes.Super{})
+	bz, err = guardiantestutil.QuerySuperExec(clientCtx, from.String())
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), respType))
+	super := respType.(*guardiantypes.Super)
+	s.Require().Equal(description, super.Description)
+	s.Require().Equal(addr.String(), super.AddedBy)
+
 	//------test GetCmdDeleteSuper()-------------
 	args = []string{
 		fmt.Sprintf("--%s=%s", guardiancli.FlagAddress, from.String()),
@@ -8,16 +8,54 @@ import (
 const (
 	FlagAddress     = "address"
 	FlagDescription = "description"
+	FlagScope       = "scope"
+	FlagExpiresAt   = "expires-at"
+	FlagWithin      = "within"
+	FlagNewAddress  = "new-address"
+	FlagCascade     = "cascade"
+
+	FlagSuperDescription = "super-description"
+	FlagAccountType      = "account-type"
+	FlagAddedBy          = "added-by"
+	FlagOperator         = "operator"
+
+	FlagRecipient = "recipient"
+	FlagAmount    = "amount"
+
+	FlagExpiryHeight = "expiry-height"
+	FlagReason       = "reason"
 )
 
 // common flagsets to add to various functions
 var (
 	FsAddGuardian    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsDeleteGuardian = flag.NewFlagSet("", flag.ContinueOnError)
+	FsScope          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsProposeAdd     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsProposeDelete  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSpend          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsRotate         = flag.NewFlagSet("", flag.ContinueOnError)
+	FsUpdateGuardian = flag.NewFlagSet("", flag.ContinueOnError)
+	FsPause          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsBlock          = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
 	FsAddGuardian.String(FlagAddress, "", "bech32 encoded account address")
 	FsAddGuardian.String(FlagDescription, "", "description of account")
+	FsAddGuardian.String(FlagExpiresAt, "", "optional expiration time of the super in RFC3339 format (e.g. 2021-01-02T15:04:05Z)")
 	FsDeleteGuardian.String(FlagAddress, "", "bech32 encoded account address")
+	FsDeleteGuardian.Bool(FlagCascade, false, "whether to delete the supers added by the account as well, recursively")
+	FsScope.String(FlagAddress, "", "bech32 encoded account address")
+	FsScope.String(FlagScope, "", "permission scope (oracle | circuit-breaker | blocklist)")
+	FsProposeAdd.String(FlagAddress, "", "bech32 encoded account address")
+	FsProposeAdd.String(FlagDescription, "", "description of account")
+	FsProposeDelete.String(FlagAddress, "", "bech32 encoded account address")
+	FsUpdateGuardian.String(FlagAddress, "", "bech32 encoded address of the super to update, defaults to the sender")
+	FsUpdateGuardian.String(FlagDescription, "", "new description of account")
+	FsRotate.String(FlagNewAddress, "", "bech32 encoded address to move the super to")
+	FsSpend.String(FlagRecipient, "", "bech32 encoded recipient address")
+	FsSpend.String(FlagAmount, "", "amount of coins to spend from the community pool")
+	FsPause.Int64(FlagExpiryHeight, 0, "optional height from which the message types are enabled again")
+	FsBlock.String(FlagReason, "", "optional reason for blocking the account")
 }
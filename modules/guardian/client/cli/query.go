@@ -3,11 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -23,11 +26,85 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 	txCmd.AddCommand(
+		GetCmdQuerySuper(),
 		GetCmdQuerySupers(),
+		GetCmdQuerySuperDescendants(),
+		GetCmdQueryExpiringSupers(),
+		GetCmdQueryTrustee(),
+		GetCmdQueryTrustees(),
+		GetCmdQueryHistory(),
+		GetCmdQueryMembershipProposals(),
+		GetCmdQueryMembershipProposal(),
+		GetCmdQueryParams(),
+		GetCmdQueryPausedMsgs(),
+		GetCmdQueryBlockedAccount(),
+		GetCmdQueryBlockedAccounts(),
 	)
 	return txCmd
 }
 
+// GetCmdQuerySuper implements the query super command.
+func GetCmdQuerySuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "super [address]",
+		Short:   "Query a super by address",
+		Example: fmt.Sprintf("%s query guardian super <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Super(context.Background(), &types.QuerySuperRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Super)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySuperDescendants implements the query super descendants command.
+func GetCmdQuerySuperDescendants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "descendants [address]",
+		Short:   "Query the supers which a cascading deletion of the given super would remove",
+		Example: fmt.Sprintf("%s query guardian descendants <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SuperDescendants(context.Background(), &types.QuerySuperDescendantsRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQuerySupers implements the query supers command.
 func GetCmdQuerySupers() *cobra.Command {
 	cmd := &cobra.Command{
@@ -47,7 +124,17 @@ func GetCmdQuerySupers() *cobra.Command {
 				return err
 			}
 
-			res, err := queryClient.Supers(context.Background(), &types.QuerySupersRequest{Pagination: pageReq})
+			accountType, _ := cmd.Flags().GetString(FlagAccountType)
+			addedBy, _ := cmd.Flags().GetString(FlagAddedBy)
+
+			res, err := queryClient.Supers(
+				context.Background(),
+				&types.QuerySupersRequest{
+					Pagination:  pageReq,
+					AccountType: accountType,
+					AddedBy:     addedBy,
+				},
+			)
 			if err != nil {
 				return err
 			}
@@ -56,6 +143,342 @@ func GetCmdQuerySupers() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAccountType, "", "filter the supers by account type (Genesis | Ordinary)")
+	cmd.Flags().String(FlagAddedBy, "", "filter the supers by the bech32 address which added them")
 	flags.AddPaginationFlagsToCmd(cmd, "all supper")
 	return cmd
 }
+
+// GetCmdQueryExpiringSupers implements the query expiring supers command.
+func GetCmdQueryExpiringSupers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "expiring-supers",
+		Short:   "Query for the supers expiring soon",
+		Example: fmt.Sprintf("%s query guardian expiring-supers --within=24h", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			within, err := cmd.Flags().GetDuration(FlagWithin)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExpiringSupers(context.Background(), &types.QueryExpiringSupersRequest{Within: within})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Duration(FlagWithin, 24*time.Hour, "duration from the current block time within which the supers expire")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryHistory implements the query history command.
+func GetCmdQueryHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "Query the change log of the guardian membership",
+		Example: fmt.Sprintf("%s query guardian history --address=<super address> --operator=<operator address>", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, _ := cmd.Flags().GetString(FlagAddress)
+			operator, _ := cmd.Flags().GetString(FlagOperator)
+
+			res, err := queryClient.History(
+				context.Background(),
+				&types.QueryHistoryRequest{
+					Pagination: pageReq,
+					Address:    address,
+					Operator:   operator,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagAddress, "", "filter the history by the bech32 address of the changed super")
+	cmd.Flags().String(FlagOperator, "", "filter the history by the bech32 address which made the change")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "guardian history")
+	return cmd
+}
+
+// GetCmdQueryTrustee implements the query trustee command.
+func GetCmdQueryTrustee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trustee [address]",
+		Short:   "Query a trustee by address",
+		Example: fmt.Sprintf("%s query guardian trustee <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Trustee(context.Background(), &types.QueryTrusteeRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Trustee)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTrustees implements the query trustees command.
+func GetCmdQueryTrustees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "trustees",
+		Short:   "Query for all trustees",
+		Example: fmt.Sprintf("%s query guardian trustees", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Trustees(
+				context.Background(),
+				&types.QueryTrusteesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all trustees")
+	return cmd
+}
+
+// GetCmdQueryMembershipProposals implements the query membership proposals command.
+func GetCmdQueryMembershipProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposals",
+		Short:   "Query for all pending membership proposals",
+		Example: fmt.Sprintf("%s query guardian proposals", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MembershipProposals(
+				context.Background(),
+				&types.QueryMembershipProposalsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all membership proposals")
+	return cmd
+}
+
+// GetCmdQueryMembershipProposal implements the query membership proposal command.
+func GetCmdQueryMembershipProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "proposal [proposal-id]",
+		Short:   "Query a membership proposal",
+		Example: fmt.Sprintf("%s query guardian proposal <proposal-id>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MembershipProposal(
+				context.Background(),
+				&types.QueryMembershipProposalRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Proposal)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the guardian parameters",
+		Example: fmt.Sprintf("%s query guardian params", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPausedMsgs implements the query paused message types command.
+func GetCmdQueryPausedMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused-msgs",
+		Short:   "Query the message types paused by the circuit breaker",
+		Example: fmt.Sprintf("%s query guardian paused-msgs", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PausedMsgs(context.Background(), &types.QueryPausedMsgsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBlockedAccount implements the query blocked account command.
+func GetCmdQueryBlockedAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocked-account [address]",
+		Short:   "Query a blocked account by address",
+		Example: fmt.Sprintf("%s query guardian blocked-account <address>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockedAccount(context.Background(), &types.QueryBlockedAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.BlockedAccount)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBlockedAccounts implements the query blocked accounts command.
+func GetCmdQueryBlockedAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocked-accounts",
+		Short:   "Query for all blocked accounts",
+		Example: fmt.Sprintf("%s query guardian blocked-accounts", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BlockedAccounts(
+				context.Background(),
+				&types.QueryBlockedAccountsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all blocked accounts")
+	return cmd
+}
@@ -2,12 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/spf13/cobra"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -25,6 +29,21 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetCmdCreateSuper(),
 		GetCmdDeleteSuper(),
+		GetCmdGrantScope(),
+		GetCmdRevokeScope(),
+		GetCmdRotateSuper(),
+		GetCmdUpdateSuper(),
+		GetCmdProposeAddSuper(),
+		GetCmdProposeDeleteSuper(),
+		GetCmdApproveProposal(),
+		GetCmdExecuteProposal(),
+		GetCmdAddTrustee(),
+		GetCmdDeleteTrustee(),
+		GetCmdSpendCommunityPool(),
+		GetCmdPauseMsgs(),
+		GetCmdUnpauseMsgs(),
+		GetCmdBlockAccount(),
+		GetCmdUnblockAccount(),
 	)
 	return txCmd
 }
@@ -56,6 +75,14 @@ func GetCmdCreateSuper() *cobra.Command {
 			}
 			description, _ := cmd.Flags().GetString(FlagDescription)
 			msg := types.NewMsgAddSuper(description, pAddr, fromAddr)
+
+			if expiresAtStr, _ := cmd.Flags().GetString(FlagExpiresAt); len(expiresAtStr) > 0 {
+				expiresAt, err := time.Parse(time.RFC3339, expiresAtStr)
+				if err != nil {
+					return err
+				}
+				msg.ExpiresAt = &expiresAt
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -76,7 +103,333 @@ func GetCmdDeleteSuper() *cobra.Command {
 		Use:   "delete-super",
 		Short: "Delete a super",
 		Example: fmt.Sprintf(
-			"%s tx guardian delete-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<deleted address>",
+			"%s tx guardian delete-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<deleted address> [--cascade]",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			cascade, _ := cmd.Flags().GetBool(FlagCascade)
+			msg := types.NewMsgDeleteSuper(pAddr, fromAddr, cascade)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsDeleteGuardian)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGrantScope implements the grant scope command.
+func GetCmdGrantScope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-scope",
+		Short: "Grant a permission scope to a super",
+		Example: fmt.Sprintf(
+			"%s tx guardian grant-scope --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<super address> --scope=<oracle|circuit-breaker|blocklist>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			scopeStr, _ := cmd.Flags().GetString(FlagScope)
+			scope, err := types.ScopeFromString(scopeStr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgGrantScope(pAddr, scope, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsScope)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagScope)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRevokeScope implements the revoke scope command.
+func GetCmdRevokeScope() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-scope",
+		Short: "Revoke a permission scope from a super",
+		Example: fmt.Sprintf(
+			"%s tx guardian revoke-scope --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<super address> --scope=<oracle|circuit-breaker|blocklist>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			scopeStr, _ := cmd.Flags().GetString(FlagScope)
+			scope, err := types.ScopeFromString(scopeStr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRevokeScope(pAddr, scope, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsScope)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagScope)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRotateSuper implements the rotate super command.
+func GetCmdRotateSuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-super",
+		Short: "Move the super signing the transaction to a new address",
+		Example: fmt.Sprintf(
+			"%s tx guardian rotate-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --new-address=<new address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			newAddrStr, _ := cmd.Flags().GetString(FlagNewAddress)
+			newAddr, err := sdk.AccAddressFromBech32(newAddrStr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRotateSuper(fromAddr, newAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsRotate)
+	_ = cmd.MarkFlagRequired(FlagNewAddress)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdateSuper implements the update super command.
+func GetCmdUpdateSuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-super",
+		Short: "Update the description of a super",
+		Example: fmt.Sprintf(
+			"%s tx guardian update-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<super address> --description=<name>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			pAddr := fromAddr
+			if paStr, _ := cmd.Flags().GetString(FlagAddress); len(paStr) > 0 {
+				if pAddr, err = sdk.AccAddressFromBech32(paStr); err != nil {
+					return err
+				}
+			}
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			msg := types.NewMsgUpdateSuper(description, pAddr, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsUpdateGuardian)
+	_ = cmd.MarkFlagRequired(FlagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdProposeAddSuper implements the propose add super command.
+func GetCmdProposeAddSuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-add-super",
+		Short: "Propose to add a new super",
+		Example: fmt.Sprintf(
+			"%s tx guardian propose-add-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			msg := types.NewMsgSubmitMembershipProposal(types.ActionAddSuper, pAddr, description, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsProposeAdd)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdProposeDeleteSuper implements the propose delete super command.
+func GetCmdProposeDeleteSuper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-delete-super",
+		Short: "Propose to delete a super",
+		Example: fmt.Sprintf(
+			"%s tx guardian propose-delete-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<deleted address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSubmitMembershipProposal(types.ActionDeleteSuper, pAddr, "", fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsProposeDelete)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdApproveProposal implements the approve membership proposal command.
+func GetCmdApproveProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-proposal [proposal-id]",
+		Short: "Approve a pending membership proposal",
+		Example: fmt.Sprintf(
+			"%s tx guardian approve-proposal <proposal-id> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgApproveMembershipProposal(proposalID, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdExecuteProposal implements the execute membership proposal command.
+func GetCmdExecuteProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-proposal [proposal-id]",
+		Short: "Execute a membership proposal which has enough approvals",
+		Example: fmt.Sprintf(
+			"%s tx guardian execute-proposal <proposal-id> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgExecuteMembershipProposal(proposalID, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddTrustee implements the add trustee command.
+func GetCmdAddTrustee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-trustee",
+		Short: "Add a trustee",
+		Example: fmt.Sprintf(
+			"%s tx guardian add-trustee --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<added address> --description=<name>",
 			version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -91,7 +444,44 @@ func GetCmdDeleteSuper() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgDeleteSuper(pAddr, fromAddr)
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			msg := types.NewMsgAddTrustee(description, pAddr, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsProposeAdd)
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagDescription)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDeleteTrustee implements the delete trustee command.
+func GetCmdDeleteTrustee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-trustee",
+		Short: "Delete a trustee",
+		Example: fmt.Sprintf(
+			"%s tx guardian delete-trustee --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --address=<deleted address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDeleteTrustee(pAddr, fromAddr)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -104,3 +494,277 @@ func GetCmdDeleteSuper() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdSpendCommunityPool implements the spend community pool command.
+func GetCmdSpendCommunityPool() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spend-community-pool",
+		Short: "Spend coins from the community pool as a trustee",
+		Example: fmt.Sprintf(
+			"%s tx guardian spend-community-pool --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --recipient=<recipient address> --amount=10iris",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			recipientStr, _ := cmd.Flags().GetString(FlagRecipient)
+			recipient, err := sdk.AccAddressFromBech32(recipientStr)
+			if err != nil {
+				return err
+			}
+			amountStr, _ := cmd.Flags().GetString(FlagAmount)
+			amount, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSpendCommunityPool(recipient, amount, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsSpend)
+	_ = cmd.MarkFlagRequired(FlagRecipient)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPauseMsgs implements the pause message types command.
+func GetCmdPauseMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-msgs [type-url]...",
+		Short: "Disable the given message types with the circuit breaker",
+		Example: fmt.Sprintf(
+			"%s tx guardian pause-msgs /cosmos.bank.v1beta1.MsgSend --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --expiry-height=<height>",
+			version.AppName,
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, _ := cmd.Flags().GetInt64(FlagExpiryHeight)
+			msg := types.NewMsgPauseMsgs(args, expiryHeight, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsPause)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnpauseMsgs implements the unpause message types command.
+func GetCmdUnpauseMsgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-msgs [type-url]...",
+		Short: "Enable the given message types paused by the circuit breaker",
+		Example: fmt.Sprintf(
+			"%s tx guardian unpause-msgs /cosmos.bank.v1beta1.MsgSend --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpauseMsgs(args, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBlockAccount implements the block account command.
+func GetCmdBlockAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-account [address]",
+		Short: "Add an account to the blocklist",
+		Example: fmt.Sprintf(
+			"%s tx guardian block-account <address> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --reason=<reason>",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			reason, _ := cmd.Flags().GetString(FlagReason)
+			msg := types.NewMsgBlockAccount(address, reason, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsBlock)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnblockAccount implements the unblock account command.
+func GetCmdUnblockAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblock-account [address]",
+		Short: "Remove an account from the blocklist",
+		Example: fmt.Sprintf(
+			"%s tx guardian unblock-account <address> --chain-id=<chain-id> --from=<key-name> --fees=0.3iris",
+			version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUnblockAccount(address, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSubmitAddSuperProposal implements the command to submit an add super proposal.
+func GetCmdSubmitAddSuperProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-super",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to add a super",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal add-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --title=<title> --description=<description> --deposit=<deposit> --address=<added address> --super-description=<name> --account-type=<Genesis|Ordinary>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+			depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+			superDescription, _ := cmd.Flags().GetString(FlagSuperDescription)
+			accountTypeStr, _ := cmd.Flags().GetString(FlagAccountType)
+			accountType, err := types.AccountTypeFromString(accountTypeStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddSuperProposal(title, description, pAddr, superDescription, accountType)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagAddress, "", "bech32 encoded account address")
+	cmd.Flags().String(FlagSuperDescription, "", "description of account")
+	cmd.Flags().String(FlagAccountType, types.Ordinary.String(), "account type of the super (Genesis | Ordinary)")
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagSuperDescription)
+	return cmd
+}
+
+// GetCmdSubmitDeleteSuperProposal implements the command to submit a delete super proposal.
+func GetCmdSubmitDeleteSuperProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-super",
+		Args:  cobra.NoArgs,
+		Short: "Submit a proposal to delete a super",
+		Example: fmt.Sprintf(
+			"%s tx gov submit-proposal delete-super --chain-id=<chain-id> --from=<key-name> --fees=0.3iris --title=<title> --description=<description> --deposit=<deposit> --address=<deleted address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, _ := cmd.Flags().GetString(govcli.FlagTitle)
+			description, _ := cmd.Flags().GetString(govcli.FlagDescription)
+			depositStr, _ := cmd.Flags().GetString(govcli.FlagDeposit)
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			paStr, _ := cmd.Flags().GetString(FlagAddress)
+			pAddr, err := sdk.AccAddressFromBech32(paStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewDeleteSuperProposal(title, description, pAddr)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagAddress, "", "bech32 encoded account address")
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	return cmd
+}
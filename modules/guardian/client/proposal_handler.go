@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/irisnet/irishub/modules/guardian/client/cli"
+	"github.com/irisnet/irishub/modules/guardian/client/rest"
+)
+
+// guardian proposal handlers
+var (
+	AddSuperProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitAddSuperProposal, rest.AddSuperProposalRESTHandler)
+	DeleteSuperProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitDeleteSuperProposal, rest.DeleteSuperProposalRESTHandler)
+)
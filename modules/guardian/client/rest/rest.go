@@ -0,0 +1,133 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Rest variable names
+// nolint
+const (
+	RestAddress = "address"
+)
+
+// RegisterHandlers registers guardian module REST handlers on the provided router.
+func RegisterHandlers(clientCtx client.Context, r *mux.Router) {
+	registerTxRoutes(clientCtx, r)
+}
+
+// AddSuperProposalReq defines an add super proposal request body
+type AddSuperProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title            string         `json:"title" yaml:"title"`
+	Description      string         `json:"description" yaml:"description"`
+	Address          sdk.AccAddress `json:"address" yaml:"address"`
+	SuperDescription string         `json:"super_description" yaml:"super_description"`
+	AccountType      string         `json:"account_type" yaml:"account_type"`
+	Deposit          sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// DeleteSuperProposalReq defines a delete super proposal request body
+type DeleteSuperProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Address     sdk.AccAddress `json:"address" yaml:"address"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// AddSuperProposalRESTHandler returns a ProposalRESTHandler that exposes the add super REST handler
+func AddSuperProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "add_super",
+		Handler:  postAddSuperProposalHandlerFn(clientCtx),
+	}
+}
+
+// DeleteSuperProposalRESTHandler returns a ProposalRESTHandler that exposes the delete super REST handler
+func DeleteSuperProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "delete_super",
+		Handler:  postDeleteSuperProposalHandlerFn(clientCtx),
+	}
+}
+
+func postAddSuperProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddSuperProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		accountType := types.Ordinary
+		if len(req.AccountType) > 0 {
+			accountType, err = types.AccountTypeFromString(req.AccountType)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+		}
+
+		content := types.NewAddSuperProposal(req.Title, req.Description, req.Address, req.SuperDescription, accountType)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postDeleteSuperProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DeleteSuperProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		content := types.NewDeleteSuperProposal(req.Title, req.Description, req.Address)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
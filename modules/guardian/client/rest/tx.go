@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+func registerTxRoutes(clientCtx client.Context, r *mux.Router) {
+	// update the description of a super
+	r.HandleFunc(fmt.Sprintf("/%s/supers/{%s}", types.ModuleName, RestAddress), updateSuperHandlerFn(clientCtx)).Methods("PUT")
+}
+
+// UpdateSuperReq defines the properties of an update super request's body
+type UpdateSuperReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Description string       `json:"description" yaml:"description"`
+}
+
+// HTTP request handler to update the description of a super
+func updateSuperHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address, err := sdk.AccAddressFromBech32(mux.Vars(r)[RestAddress])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req UpdateSuperReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		updatedBy, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgUpdateSuper(req.Description, address, updatedBy)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -40,3 +40,13 @@ func QuerySupersExec(clientCtx client.Context, extraArgs ...string) (testutil.Bu
 
 	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQuerySupers(), args)
 }
+
+func QuerySuperExec(clientCtx client.Context, address string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		address,
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, guardiancli.GetCmdQuerySuper(), args)
+}
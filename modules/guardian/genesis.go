@@ -17,6 +17,41 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	// Add supers
 	for _, super := range data.Supers {
 		keeper.AddSuper(ctx, super)
+
+		address, _ := sdk.AccAddressFromBech32(super.Address)
+		keeper.AfterSuperAdded(ctx, address)
+	}
+	// Add trustees
+	for _, trustee := range data.Trustees {
+		keeper.AddTrustee(ctx, trustee)
+	}
+
+	keeper.SetParamSet(ctx, data.Params)
+
+	// Restore pending membership proposals
+	for _, proposal := range data.Proposals {
+		keeper.SetMembershipProposal(ctx, proposal)
+	}
+	keeper.SetNextProposalID(ctx, data.NextProposalId)
+
+	// Restore the membership change log
+	nextHistoryID := uint64(1)
+	for _, entry := range data.History {
+		keeper.SetHistoryEntry(ctx, entry)
+		if entry.Id >= nextHistoryID {
+			nextHistoryID = entry.Id + 1
+		}
+	}
+	keeper.SetNextHistoryID(ctx, nextHistoryID)
+
+	// Restore the message types paused by the circuit breaker
+	for _, pausedMsg := range data.PausedMsgs {
+		keeper.SetPausedMsg(ctx, pausedMsg)
+	}
+
+	// Restore the account blocklist
+	for _, account := range data.BlockedAccounts {
+		keeper.SetBlockedAccount(ctx, account)
 	}
 }
 
@@ -31,19 +66,240 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(supers)
+	var proposals []types.MembershipProposal
+	k.IterateMembershipProposals(
+		ctx,
+		func(proposal types.MembershipProposal) bool {
+			proposals = append(proposals, proposal)
+			return false
+		},
+	)
+
+	var history []types.HistoryEntry
+	k.IterateHistory(
+		ctx,
+		func(entry types.HistoryEntry) bool {
+			history = append(history, entry)
+			return false
+		},
+	)
+
+	var trustees []types.Trustee
+	k.IterateTrustees(
+		ctx,
+		func(trustee types.Trustee) bool {
+			trustees = append(trustees, trustee)
+			return false
+		},
+	)
+
+	var pausedMsgs []types.PausedMsg
+	k.IteratePausedMsgs(
+		ctx,
+		func(pausedMsg types.PausedMsg) bool {
+			pausedMsgs = append(pausedMsgs, pausedMsg)
+			return false
+		},
+	)
+
+	var blockedAccounts []types.BlockedAccount
+	k.IterateBlockedAccounts(
+		ctx,
+		func(account types.BlockedAccount) bool {
+			blockedAccounts = append(blockedAccounts, account)
+			return false
+		},
+	)
+
+	return types.NewGenesisState(
+		supers, k.GetParamSet(ctx), proposals, k.GetNextProposalID(ctx), history, trustees, pausedMsgs, blockedAccounts,
+	)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data types.GenesisState) error {
-	for _, super := range data.Supers {
-		if _, err := sdk.AccAddressFromBech32(super.Address); err != nil {
-			return err
+	if err := validateSupers(data.Supers); err != nil {
+		return err
+	}
+	if err := validateTrustees(data.Trustees); err != nil {
+		return err
+	}
+
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.NextProposalId == 0 {
+		return fmt.Errorf("next proposal id must be positive")
+	}
+	proposalIDs := make(map[uint64]bool, len(data.Proposals))
+	for _, proposal := range data.Proposals {
+		if proposal.Id == 0 || proposal.Id >= data.NextProposalId || proposalIDs[proposal.Id] {
+			return fmt.Errorf("invalid or duplicate membership proposal id %d; next proposal id: %d", proposal.Id, data.NextProposalId)
+		}
+		proposalIDs[proposal.Id] = true
+
+		msg := types.MsgSubmitMembershipProposal{
+			Action:      proposal.Action,
+			Address:     proposal.Address,
+			Description: proposal.Description,
+			Proposer:    proposal.Proposer,
 		}
-		if _, err := sdk.AccAddressFromBech32(super.AddedBy); err != nil {
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid membership proposal %d: %w", proposal.Id, err)
+		}
+	}
+
+	if err := validatePausedMsgs(data.PausedMsgs); err != nil {
+		return err
+	}
+	if err := validateBlockedAccounts(data.BlockedAccounts, data.Supers); err != nil {
+		return err
+	}
+
+	historyIDs := make(map[uint64]bool, len(data.History))
+	for _, entry := range data.History {
+		if entry.Id == 0 || historyIDs[entry.Id] {
+			return fmt.Errorf("invalid or duplicate history entry id %d", entry.Id)
+		}
+		historyIDs[entry.Id] = true
+
+		if !types.ValidHistoryAction(entry.Action) {
+			return fmt.Errorf("invalid action of history entry %d: %s", entry.Id, entry.Action)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
 			return err
 		}
+		if len(entry.Operator) > 0 {
+			if _, err := sdk.AccAddressFromBech32(entry.Operator); err != nil {
+				return err
+			}
+		}
+		if entry.Action == types.ActionRotateSuper {
+			if _, err := sdk.AccAddressFromBech32(entry.PreviousAddress); err != nil {
+				return fmt.Errorf("invalid previous address of history entry %d: %w", entry.Id, err)
+			}
+		} else if len(entry.PreviousAddress) > 0 {
+			return fmt.Errorf("previous address of history entry %d must be empty for action %s", entry.Id, entry.Action)
+		}
+	}
+	return nil
+}
+
+// validateSupers checks the supers against the rules of MsgAddSuper. The supers must be unique
+// and contain at least one genesis super, so that the guardian set can be managed
+func validateSupers(supers []types.Super) error {
+	addresses := make(map[string]bool, len(supers))
+	hasGenesis := false
+	for _, super := range supers {
+		if addresses[super.Address] {
+			return fmt.Errorf("duplicate super %s", super.Address)
+		}
+		addresses[super.Address] = true
+
+		msg := types.MsgAddSuper{
+			Description: super.Description,
+			Address:     super.Address,
+			AddedBy:     super.AddedBy,
+			ExpiresAt:   super.ExpiresAt,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid super %s: %w", super.Address, err)
+		}
+		if !types.ValidAccountType(super.AccountType) {
+			return fmt.Errorf("invalid account type of super %s: %d", super.Address, super.AccountType)
+		}
+		for _, scope := range super.Scopes {
+			if !types.ValidScope(scope) {
+				return fmt.Errorf("invalid scope of super %s: %d", super.Address, scope)
+			}
+		}
+
+		if super.AccountType == types.Genesis {
+			if super.ExpiresAt != nil {
+				return fmt.Errorf("genesis super %s must not expire", super.Address)
+			}
+			hasGenesis = true
+		}
+	}
+
+	if !hasGenesis {
+		return fmt.Errorf("at least one genesis super is required")
+	}
+	return nil
+}
+
+// validateTrustees checks the trustees against the rules of MsgAddTrustee
+func validateTrustees(trustees []types.Trustee) error {
+	addresses := make(map[string]bool, len(trustees))
+	for _, trustee := range trustees {
+		if addresses[trustee.Address] {
+			return fmt.Errorf("duplicate trustee %s", trustee.Address)
+		}
+		addresses[trustee.Address] = true
+
+		msg := types.MsgAddTrustee{
+			Description: trustee.Description,
+			Address:     trustee.Address,
+			AddedBy:     trustee.AddedBy,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid trustee %s: %w", trustee.Address, err)
+		}
+		if !types.ValidAccountType(trustee.AccountType) {
+			return fmt.Errorf("invalid account type of trustee %s: %d", trustee.Address, trustee.AccountType)
+		}
+	}
+	return nil
+}
+
+// validatePausedMsgs checks the paused message types against the rules of MsgPauseMsgs
+func validatePausedMsgs(pausedMsgs []types.PausedMsg) error {
+	typeURLs := make([]string, len(pausedMsgs))
+	for i, pausedMsg := range pausedMsgs {
+		typeURLs[i] = pausedMsg.TypeUrl
+
+		if pausedMsg.ExpiryHeight < 0 {
+			return fmt.Errorf("invalid expiry height of paused message %s: %d", pausedMsg.TypeUrl, pausedMsg.ExpiryHeight)
+		}
+		if _, err := sdk.AccAddressFromBech32(pausedMsg.PausedBy); err != nil {
+			return fmt.Errorf("invalid operator of paused message %s: %w", pausedMsg.TypeUrl, err)
+		}
+	}
+	if len(typeURLs) == 0 {
+		return nil
+	}
+	return types.ValidatePausableTypeURLs(typeURLs)
+}
+
+// validateBlockedAccounts checks the blocked accounts against the rules of MsgBlockAccount.
+// The blocked accounts must be unique and must not be supers
+func validateBlockedAccounts(accounts []types.BlockedAccount, supers []types.Super) error {
+	superAddresses := make(map[string]bool, len(supers))
+	for _, super := range supers {
+		superAddresses[super.Address] = true
+	}
+
+	addresses := make(map[string]bool, len(accounts))
+	for _, account := range accounts {
+		if addresses[account.Address] {
+			return fmt.Errorf("duplicate blocked account %s", account.Address)
+		}
+		addresses[account.Address] = true
+
+		if superAddresses[account.Address] {
+			return fmt.Errorf("super %s can not be blocked", account.Address)
+		}
+
+		msg := types.MsgBlockAccount{
+			Address:   account.Address,
+			Reason:    account.Reason,
+			BlockedBy: account.BlockedBy,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid blocked account %s: %w", account.Address, err)
+		}
 	}
 	return nil
 }
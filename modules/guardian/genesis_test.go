@@ -1,7 +1,9 @@
 package guardian_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -39,5 +41,145 @@ func TestGenesisSuite(t *testing.T) {
 func (suite *TestSuite) TestExportGenesis() {
 	exportedGenesis := guardian.ExportGenesis(suite.ctx, suite.keeper)
 	defaultGenesis := types.DefaultGenesisState()
+	defaultGenesis.Supers = []types.Super{
+		types.NewSuper("genesis", types.Genesis, simapp.GenesisSuper, simapp.GenesisSuper),
+	}
 	suite.Equal(exportedGenesis, defaultGenesis)
 }
+
+func (suite *TestSuite) TestHistoryGenesis() {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	super := types.NewSuper("test", types.Ordinary, addr, addr)
+	suite.keeper.AppendHistory(suite.ctx, types.ActionAddSuper, super, addr.String())
+	suite.keeper.AppendHistory(suite.ctx, types.ActionDeleteSuper, super, "")
+	rotated := types.NewSuper("test", types.Ordinary, sdk.AccAddress([]byte("addr2_______________")), addr)
+	suite.keeper.AppendRotationHistory(suite.ctx, rotated, addr.String(), addr.String())
+
+	exportedGenesis := guardian.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Len(exportedGenesis.History, 3)
+	suite.NoError(guardian.ValidateGenesis(*exportedGenesis))
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	guardian.InitGenesis(ctx, app.GuardianKeeper, *exportedGenesis)
+
+	suite.Equal(exportedGenesis.History, guardian.ExportGenesis(ctx, app.GuardianKeeper).History)
+	suite.Equal(uint64(4), app.GuardianKeeper.GetNextHistoryID(ctx))
+
+	// a rotation entry must refer to the previous address, which other entries must not
+	history := exportedGenesis.History
+	exportedGenesis.History = []types.HistoryEntry{history[0], history[1], history[2]}
+	exportedGenesis.History[2].PreviousAddress = ""
+	suite.Error(guardian.ValidateGenesis(*exportedGenesis))
+	exportedGenesis.History = []types.HistoryEntry{history[0], history[1], history[2]}
+	exportedGenesis.History[0].PreviousAddress = addr.String()
+	suite.Error(guardian.ValidateGenesis(*exportedGenesis))
+
+	exportedGenesis.History = append(history, history[0])
+	suite.Error(guardian.ValidateGenesis(*exportedGenesis))
+}
+
+func (suite *TestSuite) TestValidateGenesis() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	genesisSuper := types.NewSuper("genesis", types.Genesis, addr1, addr1)
+	ordinarySuper := types.NewSuper("ordinary", types.Ordinary, addr2, addr1)
+
+	expiringGenesis := genesisSuper
+	expiringGenesis.ExpiresAt = &expiresAt
+	invalidType := ordinarySuper
+	invalidType.AccountType = types.AccountType(0x09)
+	longDescription := ordinarySuper
+	longDescription.Description = strings.Repeat("a", types.MaxDescriptionLength+1)
+	paramDescription := ordinarySuper
+	paramDescription.Description = strings.Repeat("a", int(types.DefaultParams().MaxDescriptionLength)+1)
+
+	testCases := []struct {
+		name    string
+		supers  []types.Super
+		expPass bool
+	}{
+		{"empty guardian set", nil, false},
+		{"valid supers", []types.Super{genesisSuper, ordinarySuper}, true},
+		{"no genesis super", []types.Super{ordinarySuper}, false},
+		{"duplicate super", []types.Super{genesisSuper, ordinarySuper, ordinarySuper}, false},
+		{"description too long", []types.Super{genesisSuper, longDescription}, false},
+		{"description exceeds params", []types.Super{genesisSuper, paramDescription}, true},
+		{"invalid account type", []types.Super{genesisSuper, invalidType}, false},
+		{"expiring genesis super", []types.Super{expiringGenesis}, false},
+	}
+
+	for _, tc := range testCases {
+		data := types.DefaultGenesisState()
+		data.Supers = tc.supers
+		err := guardian.ValidateGenesis(*data)
+		if tc.expPass {
+			suite.NoError(err, tc.name)
+		} else {
+			suite.Error(err, tc.name)
+			suite.Panics(func() {
+				guardian.InitGenesis(suite.ctx, suite.keeper, *data)
+			}, tc.name)
+		}
+	}
+
+	// nothing is stored by a rejected genesis
+	supers := guardian.ExportGenesis(suite.ctx, suite.keeper).Supers
+	suite.Len(supers, 1)
+	suite.Equal(simapp.GenesisSuper.String(), supers[0].Address)
+
+	// the param limits only apply to new supers, so lowering them keeps the state exportable
+	suite.keeper.AddSuper(suite.ctx, paramDescription)
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.MaxSupers = 1
+	params.MaxDescriptionLength = 1
+	suite.keeper.SetParamSet(suite.ctx, params)
+	suite.NoError(guardian.ValidateGenesis(*guardian.ExportGenesis(suite.ctx, suite.keeper)))
+}
+
+func (suite *TestSuite) TestPausedMsgsGenesis() {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	pausedMsg := types.NewPausedMsg("/cosmos.bank.v1beta1.MsgSend", 100, addr.String())
+	suite.keeper.SetPausedMsg(suite.ctx, pausedMsg)
+
+	exportedGenesis := guardian.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal([]types.PausedMsg{pausedMsg}, exportedGenesis.PausedMsgs)
+	suite.NoError(guardian.ValidateGenesis(*exportedGenesis))
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	guardian.InitGenesis(ctx, app.GuardianKeeper, *exportedGenesis)
+	suite.True(app.GuardianKeeper.IsMsgPaused(ctx, pausedMsg.TypeUrl))
+
+	exportedGenesis.PausedMsgs = append(exportedGenesis.PausedMsgs, pausedMsg)
+	suite.Error(guardian.ValidateGenesis(*exportedGenesis))
+
+	exportedGenesis.PausedMsgs = []types.PausedMsg{types.NewPausedMsg("/irishub.guardian.MsgPauseMsgs", 0, addr.String())}
+	suite.Error(guardian.ValidateGenesis(*exportedGenesis))
+}
+
+func (suite *TestSuite) TestBlockedAccountsGenesis() {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	account := types.NewBlockedAccount(addr2, "compromised", addr1)
+	suite.keeper.SetBlockedAccount(suite.ctx, account)
+
+	exportedGenesis := guardian.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal([]types.BlockedAccount{account}, exportedGenesis.BlockedAccounts)
+	suite.NoError(guardian.ValidateGenesis(*exportedGenesis))
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	guardian.InitGenesis(ctx, app.GuardianKeeper, *exportedGenesis)
+	suite.True(app.GuardianKeeper.IsBlocked(ctx, addr2))
+
+	exportedGenesis.BlockedAccounts = append(exportedGenesis.BlockedAccounts, account)
+	suite.Error(guardian.ValidateGenesis(*exportedGenesis))
+
+	// supers can not be blocked
+	exportedGenesis.BlockedAccounts = []types.BlockedAccount{account}
+	exportedGenesis.Supers = []types.Super{types.NewSuper("genesis", types.Genesis, addr2, addr2)}
+	suite.Error(guardian.ValidateGenesis(*exportedGenesis))
+}
@@ -3,6 +3,7 @@ package guardian
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/irisnet/irishub/modules/guardian/keeper"
 	"github.com/irisnet/irishub/modules/guardian/types"
@@ -24,8 +25,80 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.DeleteSuper(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgGrantScope:
+			res, err := msgServer.GrantScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRevokeScope:
+			res, err := msgServer.RevokeScope(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSubmitMembershipProposal:
+			res, err := msgServer.SubmitMembershipProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgApproveMembershipProposal:
+			res, err := msgServer.ApproveMembershipProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgExecuteMembershipProposal:
+			res, err := msgServer.ExecuteMembershipProposal(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRotateSuper:
+			res, err := msgServer.RotateSuper(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUpdateSuper:
+			res, err := msgServer.UpdateSuper(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAddTrustee:
+			res, err := msgServer.AddTrustee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDeleteTrustee:
+			res, err := msgServer.DeleteTrustee(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSpendCommunityPool:
+			res, err := msgServer.SpendCommunityPool(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgPauseMsgs:
+			res, err := msgServer.PauseMsgs(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnpauseMsgs:
+			res, err := msgServer.UnpauseMsgs(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBlockAccount:
+			res, err := msgServer.BlockAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgUnblockAccount:
+			res, err := msgServer.UnblockAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bank message type: %T", msg)
 		}
 	}
 }
+
+// NewProposalHandler returns a handler for the guardian gov proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddSuperProposal:
+			return keeper.HandleAddSuperProposal(ctx, k, c)
+
+		case *types.DeleteSuperProposal:
+			return keeper.HandleDeleteSuperProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized guardian proposal content type: %T", c)
+		}
+	}
+}
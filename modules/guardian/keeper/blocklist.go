@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// SetBlockedAccount stores the given blocked account
+func (k Keeper) SetBlockedAccount(ctx sdk.Context, account types.BlockedAccount) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&account)
+	address, _ := sdk.AccAddressFromBech32(account.Address)
+	store.Set(types.GetBlockedAccountKey(address), bz)
+}
+
+// DeleteBlockedAccount removes the given address from the blocklist
+func (k Keeper) DeleteBlockedAccount(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBlockedAccountKey(address))
+}
+
+// GetBlockedAccount retrieves the blocked account by the specified address
+func (k Keeper) GetBlockedAccount(ctx sdk.Context, address sdk.AccAddress) (account types.BlockedAccount, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetBlockedAccountKey(address)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &account)
+		return account, true
+	}
+	return account, false
+}
+
+// IsBlocked returns true if the given address is on the blocklist
+func (k Keeper) IsBlocked(ctx sdk.Context, address sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetBlockedAccountKey(address))
+}
+
+// IterateBlockedAccounts iterates through all blocked accounts
+func (k Keeper) IterateBlockedAccounts(
+	ctx sdk.Context,
+	op func(account types.BlockedAccount) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBlockedAccountsSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var account types.BlockedAccount
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &account)
+
+		if stop := op(account); stop {
+			break
+		}
+	}
+}
+
+// BlocklistDecorator rejects the transactions signed by a blocked account as well as
+// the transfers to a blocked recipient
+type BlocklistDecorator struct {
+	k Keeper
+}
+
+// NewBlocklistDecorator creates a new BlocklistDecorator
+func NewBlocklistDecorator(k Keeper) BlocklistDecorator {
+	return BlocklistDecorator{k: k}
+}
+
+// AnteHandle returns an AnteHandler that checks the signers and recipients against the blocklist
+func (bd BlocklistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			if bd.k.IsBlocked(ctx, signer) {
+				return ctx, sdkerrors.Wrapf(types.ErrAccountBlocked, "signer %s", signer)
+			}
+		}
+
+		for _, recipient := range recipients(msg) {
+			// the recipients which are not decodable are left to the validation of the message
+			address, err := types.AccAddressFromAnyBech32(recipient)
+			if err != nil {
+				continue
+			}
+			if bd.k.IsBlocked(ctx, address) {
+				return ctx, sdkerrors.Wrapf(types.ErrAccountBlocked, "recipient %s", recipient)
+			}
+		}
+	}
+
+	// continue
+	return next(ctx, tx, simulate)
+}
+
+// recipients returns the recipients of the transfer messages
+func recipients(msg sdk.Msg) []string {
+	switch msg := msg.(type) {
+	case *banktypes.MsgSend:
+		return []string{msg.ToAddress}
+	case *banktypes.MsgMultiSend:
+		addresses := make([]string, len(msg.Outputs))
+		for i, output := range msg.Outputs {
+			addresses[i] = output.Address
+		}
+		return addresses
+	case *ibctransfertypes.MsgTransfer:
+		return []string{msg.Receiver}
+	default:
+		return nil
+	}
+}
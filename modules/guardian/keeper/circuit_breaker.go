@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// ValidateMsgTypeURL checks that the given type url refers to a message type registered with the app
+func (k Keeper) ValidateMsgTypeURL(typeURL string) error {
+	msg, err := k.interfaceRegistry.Resolve(typeURL)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidTypeURL, "unknown type url: %s", typeURL)
+	}
+	if _, ok := msg.(sdk.Msg); !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidTypeURL, "%s is not a message type", typeURL)
+	}
+	return nil
+}
+
+// SetPausedMsg stores the given paused message type
+func (k Keeper) SetPausedMsg(ctx sdk.Context, pausedMsg types.PausedMsg) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&pausedMsg)
+	store.Set(types.GetPausedMsgKey(pausedMsg.TypeUrl), bz)
+}
+
+// DeletePausedMsg enables the given message type again
+func (k Keeper) DeletePausedMsg(ctx sdk.Context, typeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPausedMsgKey(typeURL))
+}
+
+// GetPausedMsg retrieves the paused message type by the specified type url
+func (k Keeper) GetPausedMsg(ctx sdk.Context, typeURL string) (pausedMsg types.PausedMsg, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetPausedMsgKey(typeURL)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &pausedMsg)
+		return pausedMsg, true
+	}
+	return pausedMsg, false
+}
+
+// IteratePausedMsgs iterates through all paused message types
+func (k Keeper) IteratePausedMsgs(
+	ctx sdk.Context,
+	op func(pausedMsg types.PausedMsg) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPausedMsgsSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pausedMsg types.PausedMsg
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pausedMsg)
+
+		if stop := op(pausedMsg); stop {
+			break
+		}
+	}
+}
+
+// IsMsgPaused returns true if the given message type is paused at the current height
+func (k Keeper) IsMsgPaused(ctx sdk.Context, typeURL string) bool {
+	pausedMsg, found := k.GetPausedMsg(ctx, typeURL)
+	return found && !pausedMsg.IsExpired(ctx.BlockHeight())
+}
+
+// PruneExpiredPausedMsgs enables all paused message types whose expiry height has been reached
+func (k Keeper) PruneExpiredPausedMsgs(ctx sdk.Context) {
+	var expired []types.PausedMsg
+	k.IteratePausedMsgs(
+		ctx,
+		func(pausedMsg types.PausedMsg) bool {
+			if pausedMsg.IsExpired(ctx.BlockHeight()) {
+				expired = append(expired, pausedMsg)
+			}
+			return false
+		},
+	)
+
+	for _, pausedMsg := range expired {
+		k.DeletePausedMsg(ctx, pausedMsg.TypeUrl)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnpauseMsg,
+				sdk.NewAttribute(types.AttributeKeyTypeURL, pausedMsg.TypeUrl),
+				sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprintf("%d", pausedMsg.ExpiryHeight)),
+			),
+		)
+	}
+}
+
+// CircuitBreakerDecorator rejects the transactions containing a message type paused by the supers
+type CircuitBreakerDecorator struct {
+	k Keeper
+}
+
+// NewCircuitBreakerDecorator creates a new CircuitBreakerDecorator
+func NewCircuitBreakerDecorator(k Keeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{k: k}
+}
+
+// AnteHandle returns an AnteHandler that checks if any message of the transaction is paused
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		typeURL := "/" + proto.MessageName(msg)
+		if cbd.k.IsMsgPaused(ctx, typeURL) {
+			return ctx, sdkerrors.Wrap(types.ErrMsgPaused, typeURL)
+		}
+	}
+
+	// continue
+	return next(ctx, tx, simulate)
+}
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -14,20 +15,48 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+// Super implements the Query/Super gRPC method
+func (k Keeper) Super(c context.Context, req *types.QuerySuperRequest) (*types.QuerySuperResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	super, found := k.GetSuper(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "super %s not found", req.Address)
+	}
+
+	return &types.QuerySuperResponse{Super: super}, nil
+}
+
 // Supers implements the Query/Supers gRPC method
 func (k Keeper) Supers(c context.Context, req *types.QuerySupersRequest) (*types.QuerySupersResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
+	filter, err := newSuperFilter(req.AccountType, req.AddedBy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 	ctx := sdk.UnwrapSDKContext(c)
 	var supers []types.Super
-	store := ctx.KVStore(k.storeKey)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSupersSubspaceKey())
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var super types.Super
 		k.cdc.MustUnmarshalBinaryBare(value, &super)
-		supers = append(supers, super)
-		return nil
+		if !filter(super) {
+			return false, nil
+		}
+		if accumulate {
+			supers = append(supers, super)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
@@ -35,3 +64,223 @@ func (k Keeper) Supers(c context.Context, req *types.QuerySupersRequest) (*types
 
 	return &types.QuerySupersResponse{Supers: supers, Pagination: pageRes}, nil
 }
+
+// SuperDescendants implements the Query/SuperDescendants gRPC method
+func (k Keeper) SuperDescendants(c context.Context, req *types.QuerySuperDescendantsRequest) (*types.QuerySuperDescendantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetSuper(ctx, address); !found {
+		return nil, status.Errorf(codes.NotFound, "super %s not found", req.Address)
+	}
+
+	return &types.QuerySuperDescendantsResponse{Supers: k.GetSuperDescendants(ctx, address)}, nil
+}
+
+// Trustee implements the Query/Trustee gRPC method
+func (k Keeper) Trustee(c context.Context, req *types.QueryTrusteeRequest) (*types.QueryTrusteeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	trustee, found := k.GetTrustee(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "trustee %s not found", req.Address)
+	}
+
+	return &types.QueryTrusteeResponse{Trustee: trustee}, nil
+}
+
+// Trustees implements the Query/Trustees gRPC method
+func (k Keeper) Trustees(c context.Context, req *types.QueryTrusteesRequest) (*types.QueryTrusteesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var trustees []types.Trustee
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTrusteesSubspaceKey())
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var trustee types.Trustee
+		k.cdc.MustUnmarshalBinaryBare(value, &trustee)
+		trustees = append(trustees, trustee)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryTrusteesResponse{Trustees: trustees, Pagination: pageRes}, nil
+}
+
+// History implements the Query/History gRPC method
+func (k Keeper) History(c context.Context, req *types.QueryHistoryRequest) (*types.QueryHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	filter, err := newHistoryFilter(req.Address, req.Operator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var history []types.HistoryEntry
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HistoryKey)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var entry types.HistoryEntry
+		k.cdc.MustUnmarshalBinaryBare(value, &entry)
+		if !filter(entry) {
+			return false, nil
+		}
+		if accumulate {
+			history = append(history, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryHistoryResponse{History: history, Pagination: pageRes}, nil
+}
+
+// MembershipProposals implements the Query/MembershipProposals gRPC method
+func (k Keeper) MembershipProposals(c context.Context, req *types.QueryMembershipProposalsRequest) (*types.QueryMembershipProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var proposals []types.MembershipProposal
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetMembershipProposalsSubspaceKey())
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var proposal types.MembershipProposal
+		k.cdc.MustUnmarshalBinaryBare(value, &proposal)
+		proposals = append(proposals, proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryMembershipProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// MembershipProposal implements the Query/MembershipProposal gRPC method
+func (k Keeper) MembershipProposal(c context.Context, req *types.QueryMembershipProposalRequest) (*types.QueryMembershipProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetMembershipProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "membership proposal %d not found", req.ProposalId)
+	}
+
+	return &types.QueryMembershipProposalResponse{Proposal: proposal}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParamSet(ctx)}, nil
+}
+
+// ExpiringSupers implements the Query/ExpiringSupers gRPC method
+func (k Keeper) ExpiringSupers(c context.Context, req *types.QueryExpiringSupersRequest) (*types.QueryExpiringSupersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if req.Within < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "duration must not be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var supers []types.Super
+	k.IterateExpiringSupers(
+		ctx,
+		ctx.BlockTime().Add(req.Within),
+		func(super types.Super) bool {
+			supers = append(supers, super)
+			return false
+		},
+	)
+
+	return &types.QueryExpiringSupersResponse{Supers: supers}, nil
+}
+
+// PausedMsgs implements the Query/PausedMsgs gRPC method
+func (k Keeper) PausedMsgs(c context.Context, req *types.QueryPausedMsgsRequest) (*types.QueryPausedMsgsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pausedMsgs []types.PausedMsg
+	k.IteratePausedMsgs(
+		ctx,
+		func(pausedMsg types.PausedMsg) bool {
+			pausedMsgs = append(pausedMsgs, pausedMsg)
+			return false
+		},
+	)
+
+	return &types.QueryPausedMsgsResponse{PausedMsgs: pausedMsgs}, nil
+}
+
+// BlockedAccount implements the Query/BlockedAccount gRPC method
+func (k Keeper) BlockedAccount(c context.Context, req *types.QueryBlockedAccountRequest) (*types.QueryBlockedAccountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, found := k.GetBlockedAccount(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "blocked account %s not found", req.Address)
+	}
+
+	return &types.QueryBlockedAccountResponse{BlockedAccount: account}, nil
+}
+
+// BlockedAccounts implements the Query/BlockedAccounts gRPC method
+func (k Keeper) BlockedAccounts(c context.Context, req *types.QueryBlockedAccountsRequest) (*types.QueryBlockedAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var accounts []types.BlockedAccount
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBlockedAccountsSubspaceKey())
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var account types.BlockedAccount
+		k.cdc.MustUnmarshalBinaryBare(value, &account)
+		accounts = append(accounts, account)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryBlockedAccountsResponse{BlockedAccounts: accounts, Pagination: pageRes}, nil
+}
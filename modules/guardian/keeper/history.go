@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// AppendHistory records a membership change of the given super in the change log.
+// The operator is empty if the change was not made by an account, e.g. the super expired
+func (k Keeper) AppendHistory(ctx sdk.Context, action types.MembershipAction, super types.Super, operator string) {
+	k.appendHistoryEntry(ctx, types.HistoryEntry{
+		Action:      action,
+		Address:     super.Address,
+		Description: super.Description,
+		AccountType: super.AccountType,
+		Operator:    operator,
+	})
+}
+
+// AppendRotationHistory records the rotation of the given super from its previous address
+// in the change log
+func (k Keeper) AppendRotationHistory(ctx sdk.Context, super types.Super, previousAddress, operator string) {
+	k.appendHistoryEntry(ctx, types.HistoryEntry{
+		Action:          types.ActionRotateSuper,
+		Address:         super.Address,
+		Description:     super.Description,
+		AccountType:     super.AccountType,
+		Operator:        operator,
+		PreviousAddress: previousAddress,
+	})
+}
+
+// appendHistoryEntry stores the given entry with the next history id at the current block
+func (k Keeper) appendHistoryEntry(ctx sdk.Context, entry types.HistoryEntry) {
+	entry.Id = k.GetNextHistoryID(ctx)
+	entry.Height = ctx.BlockHeight()
+	entry.Time = ctx.BlockTime()
+
+	k.SetHistoryEntry(ctx, entry)
+	k.SetNextHistoryID(ctx, entry.Id+1)
+}
+
+// SetHistoryEntry stores the given history entry
+func (k Keeper) SetHistoryEntry(ctx sdk.Context, entry types.HistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&entry)
+	store.Set(types.GetHistoryKey(entry.Id), bz)
+}
+
+// IterateHistory iterates through the change log in chronological order
+func (k Keeper) IterateHistory(
+	ctx sdk.Context,
+	op func(entry types.HistoryEntry) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.HistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.HistoryEntry
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &entry)
+
+		if stop := op(entry); stop {
+			break
+		}
+	}
+}
+
+// GetNextHistoryID returns the id of the next history entry
+func (k Keeper) GetNextHistoryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextHistoryIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	var id gogotypes.UInt64Value
+	k.cdc.MustUnmarshalBinaryBare(bz, &id)
+	return id.Value
+}
+
+// SetNextHistoryID sets the id of the next history entry
+func (k Keeper) SetNextHistoryID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.NextHistoryIDKey, bz)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+var _ types.GuardianHooks = Keeper{}
+
+// AfterSuperAdded calls the registered hooks after a super is added
+func (k Keeper) AfterSuperAdded(ctx sdk.Context, address sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterSuperAdded(ctx, address)
+	}
+}
+
+// AfterSuperRemoved calls the registered hooks after a super is removed
+func (k Keeper) AfterSuperRemoved(ctx sdk.Context, address sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterSuperRemoved(ctx, address)
+	}
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// RegisterInvariants registers all guardian invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "genesis-super", GenesisSuperInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "added-by", AddedByInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "super-keys", SuperKeysInvariant(k))
+}
+
+// AllInvariants runs all invariants of the guardian module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := GenesisSuperInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = AddedByInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return SuperKeysInvariant(k)(ctx)
+	}
+}
+
+// GenesisSuperInvariant checks that at least one genesis super exists, as required by the genesis
+func GenesisSuperInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var supers, genesisSupers int
+		k.IterateSupers(
+			ctx,
+			func(super types.Super) bool {
+				supers++
+				if super.AccountType == types.Genesis {
+					genesisSupers++
+				}
+				return false
+			},
+		)
+
+		broken := genesisSupers == 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "genesis-super",
+			fmt.Sprintf("\tsupers: %d\n\tgenesis supers: %d\n", supers, genesisSupers),
+		), broken
+	}
+}
+
+// AddedByInvariant checks that every super was added by a super which exists or once existed,
+// or by the gov module account
+func AddedByInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		known := map[string]bool{
+			authtypes.NewModuleAddress(govtypes.ModuleName).String(): true,
+		}
+		k.IterateSupers(
+			ctx,
+			func(super types.Super) bool {
+				known[super.Address] = true
+				return false
+			},
+		)
+		k.IterateHistory(
+			ctx,
+			func(entry types.HistoryEntry) bool {
+				known[entry.Address] = true
+				if len(entry.PreviousAddress) > 0 {
+					known[entry.PreviousAddress] = true
+				}
+				return false
+			},
+		)
+
+		var msg string
+		count := 0
+		k.IterateSupers(
+			ctx,
+			func(super types.Super) bool {
+				if !known[super.AddedBy] {
+					count++
+					msg += fmt.Sprintf("\tsuper %s added by unknown super %s\n", super.Address, super.AddedBy)
+				}
+				return false
+			},
+		)
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "added-by",
+			fmt.Sprintf("%d supers added by unknown supers found\n%s", count, msg),
+		), broken
+	}
+}
+
+// SuperKeysInvariant checks that the key of every stored super matches its address
+func SuperKeysInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(k.storeKey)
+
+		iterator := sdk.KVStorePrefixIterator(store, types.GetSupersSubspaceKey())
+		defer iterator.Close()
+
+		var msg string
+		count := 0
+		for ; iterator.Valid(); iterator.Next() {
+			var super types.Super
+			k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &super)
+
+			keyAddress := sdk.AccAddress(iterator.Key()[len(types.GetSupersSubspaceKey()):])
+			if keyAddress.String() != super.Address {
+				count++
+				msg += fmt.Sprintf("\tsuper %s stored under the key of %s\n", super.Address, keyAddress)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "super-keys",
+			fmt.Sprintf("%d supers stored under mismatched keys found\n%s", count, msg),
+		), broken
+	}
+}
@@ -2,30 +2,64 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/guardian/types"
 )
 
 // Keeper of the guardian store
 type Keeper struct {
-	cdc      codec.Marshaler
-	storeKey sdk.StoreKey
+	cdc               codec.Marshaler
+	storeKey          sdk.StoreKey
+	paramSpace        paramtypes.Subspace
+	interfaceRegistry codectypes.InterfaceRegistry
+	bankKeeper        types.BankKeeper
+	distrKeeper       types.DistrKeeper
+	hooks             types.GuardianHooks
 }
 
 // NewKeeper returns a guardian keeper
-func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey) Keeper {
+func NewKeeper(
+	cdc codec.Marshaler,
+	key sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	keeper := Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:          key,
+		cdc:               cdc,
+		paramSpace:        paramSpace,
+		interfaceRegistry: interfaceRegistry,
+		bankKeeper:        bankKeeper,
+		distrKeeper:       distrKeeper,
 	}
 	return keeper
 }
 
+// SetHooks sets the guardian hooks
+func (k *Keeper) SetHooks(gh types.GuardianHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set guardian hooks twice")
+	}
+	k.hooks = gh
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
@@ -36,15 +70,55 @@ func (k Keeper) AddSuper(ctx sdk.Context, super types.Super) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalBinaryBare(&super)
 	address, _ := sdk.AccAddressFromBech32(super.Address)
+
+	if existing, found := k.GetSuper(ctx, address); found && existing.ExpiresAt != nil {
+		store.Delete(types.GetExpiringSuperKey(*existing.ExpiresAt, address))
+	}
+	if super.ExpiresAt != nil {
+		store.Set(types.GetExpiringSuperKey(*super.ExpiresAt, address), address)
+	}
+
 	store.Set(types.GetSuperKey(address), bz)
 }
 
 // DeleteSuper delete the stored super
 func (k Keeper) DeleteSuper(ctx sdk.Context, address sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
+	if super, found := k.GetSuper(ctx, address); found && super.ExpiresAt != nil {
+		store.Delete(types.GetExpiringSuperKey(*super.ExpiresAt, address))
+	}
 	store.Delete(types.GetSuperKey(address))
 }
 
+// RotateSuper moves the given super to the new address, keeping all its other properties.
+// The supers added by the given super are updated to refer to the new address.
+func (k Keeper) RotateSuper(ctx sdk.Context, super types.Super, newAddress sdk.AccAddress) types.Super {
+	address, _ := sdk.AccAddressFromBech32(super.Address)
+	k.DeleteSuper(ctx, address)
+
+	super.Address = newAddress.String()
+	if super.AddedBy == address.String() {
+		super.AddedBy = super.Address
+	}
+	k.AddSuper(ctx, super)
+
+	var children []types.Super
+	k.IterateSupers(
+		ctx,
+		func(child types.Super) bool {
+			if child.AddedBy == address.String() {
+				children = append(children, child)
+			}
+			return false
+		},
+	)
+	for _, child := range children {
+		child.AddedBy = super.Address
+		k.AddSuper(ctx, child)
+	}
+	return super
+}
+
 // GetSuper retrieves the super by specified address
 func (k Keeper) GetSuper(ctx sdk.Context, addr sdk.AccAddress) (super types.Super, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -55,6 +129,15 @@ func (k Keeper) GetSuper(ctx sdk.Context, addr sdk.AccAddress) (super types.Supe
 	return super, false
 }
 
+// GetActiveSuper retrieves the super by specified address, unless it has expired but has not been pruned yet
+func (k Keeper) GetActiveSuper(ctx sdk.Context, addr sdk.AccAddress) (super types.Super, found bool) {
+	super, found = k.GetSuper(ctx, addr)
+	if !found || super.IsExpired(ctx.BlockTime()) {
+		return super, false
+	}
+	return super, true
+}
+
 // IterateSupers iterates through all supers
 func (k Keeper) IterateSupers(
 	ctx sdk.Context,
@@ -75,7 +158,162 @@ func (k Keeper) IterateSupers(
 	}
 }
 
+// GetSuperDescendants returns the ordinary supers which were added by the given super,
+// directly or through other descendants, in the order of their distance to the given super.
+// Genesis supers are neither returned nor walked through.
+func (k Keeper) GetSuperDescendants(ctx sdk.Context, address sdk.AccAddress) []types.Super {
+	children := make(map[string][]types.Super)
+	k.IterateSupers(
+		ctx,
+		func(super types.Super) bool {
+			if super.AccountType != types.Genesis {
+				children[super.AddedBy] = append(children[super.AddedBy], super)
+			}
+			return false
+		},
+	)
+
+	visited := map[string]bool{address.String(): true}
+	queue := []string{address.String()}
+
+	var descendants []types.Super
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		for _, child := range children[parent] {
+			if visited[child.Address] {
+				continue
+			}
+			visited[child.Address] = true
+			descendants = append(descendants, child)
+			queue = append(queue, child.Address)
+		}
+	}
+	return descendants
+}
+
+// IterateExpiringSupers iterates through the supers which expire not later than the given time
+func (k Keeper) IterateExpiringSupers(
+	ctx sdk.Context,
+	endTime time.Time,
+	op func(super types.Super) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.ExpiringSuperKey, sdk.PrefixEndBytes(types.GetExpiringSupersByTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		super, found := k.GetSuper(ctx, iterator.Value())
+		if !found {
+			continue
+		}
+
+		if stop := op(super); stop {
+			break
+		}
+	}
+}
+
+// PruneExpiredSupers deletes all supers expired at the block time
+func (k Keeper) PruneExpiredSupers(ctx sdk.Context) {
+	var expired []types.Super
+	k.IterateExpiringSupers(
+		ctx,
+		ctx.BlockTime(),
+		func(super types.Super) bool {
+			expired = append(expired, super)
+			return false
+		},
+	)
+
+	for _, super := range expired {
+		address, _ := sdk.AccAddressFromBech32(super.Address)
+		k.DeleteSuper(ctx, address)
+		k.AppendHistory(ctx, types.ActionDeleteSuper, super, "")
+		k.AfterSuperRemoved(ctx, address)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDeleteSuper,
+				sdk.NewAttribute(types.AttributeKeySuperAddress, super.Address),
+				sdk.NewAttribute(types.AttributeKeyExpiresAt, super.ExpiresAt.Format(time.RFC3339)),
+			),
+		)
+	}
+}
+
+// Authorized returns true if the given address is a super of any type
 func (k Keeper) Authorized(ctx sdk.Context, addr sdk.AccAddress) bool {
-	_, found := k.GetSuper(ctx, addr)
+	_, found := k.GetActiveSuper(ctx, addr)
 	return found
 }
+
+// AuthorizedFor returns true if the given address is a super authorized for the specified scope
+func (k Keeper) AuthorizedFor(ctx sdk.Context, addr sdk.AccAddress, scope types.Scope) bool {
+	super, found := k.GetActiveSuper(ctx, addr)
+	return found && super.HasScope(scope)
+}
+
+// ScopeAuthorizer restricts the authorization of a guardian keeper to a single scope
+type ScopeAuthorizer struct {
+	k     Keeper
+	scope types.Scope
+}
+
+// ForScope returns a ScopeAuthorizer for the given scope, which can be used
+// wherever an Authorized(ctx, addr) method is expected
+func (k Keeper) ForScope(scope types.Scope) ScopeAuthorizer {
+	return ScopeAuthorizer{k: k, scope: scope}
+}
+
+// Authorized returns true if the given address is a super authorized for the scope
+func (sa ScopeAuthorizer) Authorized(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return sa.k.AuthorizedFor(ctx, addr, sa.scope)
+}
+
+// GetParamSet returns guardian params from the global param store
+func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParamSet set guardian params from the global param store
+func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// ValidateDescription checks the length of a super or trustee description against the params
+func (k Keeper) ValidateDescription(ctx sdk.Context, description string) error {
+	if maxLength := k.GetParamSet(ctx).MaxDescriptionLength; len(description) > int(maxLength) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid description length; got: %d, max: %d", len(description), maxLength)
+	}
+	return nil
+}
+
+// ValidateSuperCapacity returns an error if the params don't allow to add another super
+func (k Keeper) ValidateSuperCapacity(ctx sdk.Context) error {
+	maxSupers := k.GetParamSet(ctx).MaxSupers
+	if maxSupers == 0 {
+		return nil
+	}
+	if count := k.countSupers(ctx); count >= int(maxSupers) {
+		return sdkerrors.Wrapf(types.ErrTooManySupers, "got: %d, max: %d", count, maxSupers)
+	}
+	return nil
+}
+
+// countSupers returns the number of supers
+func (k Keeper) countSupers(ctx sdk.Context) int {
+	count := 0
+	k.IterateSupers(
+		ctx,
+		func(super types.Super) bool {
+			count++
+			return false
+		},
+	)
+	return count
+}
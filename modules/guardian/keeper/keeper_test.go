@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"encoding/hex"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
@@ -13,9 +15,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	"github.com/irisnet/irishub/modules/guardian"
 	"github.com/irisnet/irishub/modules/guardian/keeper"
 	"github.com/irisnet/irishub/modules/guardian/types"
+	minttypes "github.com/irisnet/irishub/modules/mint/types"
 	"github.com/irisnet/irishub/simapp"
 )
 
@@ -48,6 +59,9 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.cdc = app.LegacyAmino()
 	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
 	suite.keeper = app.GuardianKeeper
+
+	// start from an empty guardian set
+	suite.keeper.DeleteSuper(suite.ctx, simapp.GenesisSuper)
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -105,6 +119,902 @@ func (suite *KeeperTestSuite) TestQuerySupers() {
 	suite.Contains(supers, super)
 }
 
+func (suite *KeeperTestSuite) TestQuerySuper() {
+	genesis := types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0])
+	ordinary := types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0])
+	suite.keeper.AddSuper(suite.ctx, genesis)
+	suite.keeper.AddSuper(suite.ctx, ordinary)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	superRes, err := suite.keeper.Super(ctx, &types.QuerySuperRequest{Address: addrs[1].String()})
+	suite.NoError(err)
+	suite.True(ordinary.Equal(superRes.Super))
+
+	_, err = suite.keeper.Super(ctx, &types.QuerySuperRequest{Address: addrs[2].String()})
+	suite.Error(err)
+
+	supersRes, err := suite.keeper.Supers(ctx, &types.QuerySupersRequest{AccountType: types.Ordinary.String()})
+	suite.NoError(err)
+	suite.Len(supersRes.Supers, 1)
+	suite.True(ordinary.Equal(supersRes.Supers[0]))
+
+	supersRes, err = suite.keeper.Supers(ctx, &types.QuerySupersRequest{AddedBy: addrs[0].String()})
+	suite.NoError(err)
+	suite.Len(supersRes.Supers, 2)
+
+	supersRes, err = suite.keeper.Supers(ctx, &types.QuerySupersRequest{AccountType: types.Genesis.String(), AddedBy: addrs[1].String()})
+	suite.NoError(err)
+	suite.Len(supersRes.Supers, 0)
+
+	_, err = suite.keeper.Supers(ctx, &types.QuerySupersRequest{AccountType: "Unknown"})
+	suite.Error(err)
+
+	var super types.Super
+	querier := keeper.NewQuerier(suite.keeper, suite.cdc)
+	data := suite.cdc.MustMarshalJSON(types.QuerySuperParams{Address: addrs[0]})
+	res, err := querier(suite.ctx, []string{types.QuerySuper}, abci.RequestQuery{Data: data})
+	suite.NoError(err)
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &super))
+	suite.True(genesis.Equal(super))
+
+	var supers []types.Super
+	data = suite.cdc.MustMarshalJSON(types.QuerySupersParams{AccountType: types.Genesis.String()})
+	res, err = querier(suite.ctx, []string{types.QuerySupers}, abci.RequestQuery{Data: data})
+	suite.NoError(err)
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &supers))
+	suite.Len(supers, 1)
+}
+
+func (suite *KeeperTestSuite) TestHistory() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx.WithBlockHeight(10))
+
+	_, err := msgServer.AddSuper(ctx, types.NewMsgAddSuper("ordinary1", addrs[1], addrs[0]))
+	suite.NoError(err)
+	_, err = msgServer.AddSuper(ctx, types.NewMsgAddSuper("ordinary2", addrs[2], addrs[0]))
+	suite.NoError(err)
+	_, err = msgServer.DeleteSuper(ctx, types.NewMsgDeleteSuper(addrs[1], addrs[0], false))
+	suite.NoError(err)
+
+	res, err := suite.keeper.History(ctx, &types.QueryHistoryRequest{})
+	suite.NoError(err)
+	suite.Len(res.History, 3)
+	suite.Equal(types.ActionDeleteSuper, res.History[2].Action)
+	suite.Equal(addrs[1].String(), res.History[2].Address)
+	suite.Equal("ordinary1", res.History[2].Description)
+	suite.Equal(addrs[0].String(), res.History[2].Operator)
+	suite.Equal(int64(10), res.History[2].Height)
+
+	res, err = suite.keeper.History(ctx, &types.QueryHistoryRequest{Address: addrs[1].String()})
+	suite.NoError(err)
+	suite.Len(res.History, 2)
+
+	res, err = suite.keeper.History(ctx, &types.QueryHistoryRequest{Operator: addrs[1].String()})
+	suite.NoError(err)
+	suite.Len(res.History, 0)
+
+	var history []types.HistoryEntry
+	querier := keeper.NewQuerier(suite.keeper, suite.cdc)
+	data := suite.cdc.MustMarshalJSON(types.QueryHistoryParams{Address: addrs[2]})
+	bz, err := querier(suite.ctx, []string{types.QueryHistory}, abci.RequestQuery{Data: data})
+	suite.NoError(err)
+	suite.NoError(suite.cdc.UnmarshalJSON(bz, &history))
+	suite.Len(history, 1)
+}
+
+func (suite *KeeperTestSuite) TestAuthorizedFor() {
+	genesis := types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0])
+	ordinary := types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0])
+	suite.keeper.AddSuper(suite.ctx, genesis)
+	suite.keeper.AddSuper(suite.ctx, ordinary)
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	suite.True(suite.keeper.Authorized(suite.ctx, addrs[1]))
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.ScopeOracle))
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, addrs[0], types.ScopeOracle))
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, addrs[2], types.ScopeOracle))
+
+	_, err := msgServer.GrantScope(ctx, types.NewMsgGrantScope(addrs[1], types.ScopeOracle, addrs[1]))
+	suite.Error(err)
+
+	_, err = msgServer.GrantScope(ctx, types.NewMsgGrantScope(addrs[1], types.ScopeOracle, addrs[0]))
+	suite.NoError(err)
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.ScopeOracle))
+	suite.True(suite.keeper.ForScope(types.ScopeOracle).Authorized(suite.ctx, addrs[1]))
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.ScopeBlocklist))
+
+	_, err = msgServer.GrantScope(ctx, types.NewMsgGrantScope(addrs[1], types.ScopeOracle, addrs[0]))
+	suite.Error(err)
+	_, err = msgServer.GrantScope(ctx, types.NewMsgGrantScope(addrs[0], types.ScopeOracle, addrs[0]))
+	suite.Error(err)
+
+	_, err = msgServer.RevokeScope(ctx, types.NewMsgRevokeScope(addrs[1], types.ScopeBlocklist, addrs[0]))
+	suite.Error(err)
+
+	_, err = msgServer.RevokeScope(ctx, types.NewMsgRevokeScope(addrs[1], types.ScopeOracle, addrs[0]))
+	suite.NoError(err)
+	suite.False(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.ScopeOracle))
+	suite.True(suite.keeper.Authorized(suite.ctx, addrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestMigrateScopes() {
+	genesis := types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0])
+	ordinary := types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0])
+	granted := types.NewSuper("granted", types.Ordinary, addrs[2], addrs[0])
+	granted.Scopes = []types.Scope{types.ScopeBlocklist, types.ScopeOracle}
+	suite.keeper.AddSuper(suite.ctx, genesis)
+	suite.keeper.AddSuper(suite.ctx, ordinary)
+	suite.keeper.AddSuper(suite.ctx, granted)
+
+	suite.keeper.MigrateScopes(suite.ctx)
+
+	super, _ := suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.Empty(super.Scopes)
+	super, _ = suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.Equal([]types.Scope{types.ScopeOracle}, super.Scopes)
+	super, _ = suite.keeper.GetSuper(suite.ctx, addrs[2])
+	suite.Equal(granted.Scopes, super.Scopes)
+	suite.True(suite.keeper.AuthorizedFor(suite.ctx, addrs[1], types.ScopeOracle))
+}
+
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	// a running chain does not store any guardian params
+	params := types.DefaultParams()
+	store := suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey))
+	for _, pair := range params.ParamSetPairs() {
+		store.Delete(append([]byte(types.DefaultParamSpace+"/"), pair.Key...))
+	}
+	suite.Panics(func() { suite.keeper.GetParamSet(suite.ctx) })
+
+	suite.keeper.MigrateParams(suite.ctx)
+	suite.Equal(params, suite.keeper.GetParamSet(suite.ctx))
+
+	// the migration keeps the params already stored
+	params.MaxSupers = 10
+	suite.keeper.SetParamSet(suite.ctx, params)
+	suite.keeper.MigrateParams(suite.ctx)
+	suite.Equal(params, suite.keeper.GetParamSet(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestMembershipProposal() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis0", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis1", types.Genesis, addrs[1], addrs[1]))
+
+	params := types.NewParams(2, time.Hour, 0, 70, false)
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.AddSuper(ctx, types.NewMsgAddSuper("ordinary", addrs[2], addrs[0]))
+	suite.Error(err)
+
+	res, err := msgServer.SubmitMembershipProposal(ctx, types.NewMsgSubmitMembershipProposal(types.ActionAddSuper, addrs[2], "ordinary", addrs[0]))
+	suite.NoError(err)
+	suite.Equal(uint64(1), res.ProposalId)
+	suite.Equal(uint64(2), suite.keeper.GetNextProposalID(suite.ctx))
+
+	_, err = msgServer.ExecuteMembershipProposal(ctx, types.NewMsgExecuteMembershipProposal(res.ProposalId, addrs[0]))
+	suite.Error(err)
+
+	_, err = msgServer.ApproveMembershipProposal(ctx, types.NewMsgApproveMembershipProposal(res.ProposalId, addrs[0]))
+	suite.Error(err)
+	_, err = msgServer.ApproveMembershipProposal(ctx, types.NewMsgApproveMembershipProposal(res.ProposalId, addrs[1]))
+	suite.NoError(err)
+
+	_, err = msgServer.ExecuteMembershipProposal(ctx, types.NewMsgExecuteMembershipProposal(res.ProposalId, addrs[1]))
+	suite.NoError(err)
+
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[2])
+	suite.True(found)
+	suite.Equal(types.Ordinary, super.AccountType)
+	suite.Equal(addrs[0].String(), super.AddedBy)
+
+	_, found = suite.keeper.GetMembershipProposal(suite.ctx, res.ProposalId)
+	suite.False(found)
+
+	// an unexecuted proposal is pruned once expired
+	res, err = msgServer.SubmitMembershipProposal(ctx, types.NewMsgSubmitMembershipProposal(types.ActionDeleteSuper, addrs[2], "", addrs[0]))
+	suite.NoError(err)
+
+	guardian.EndBlocker(suite.ctx, suite.keeper)
+	_, found = suite.keeper.GetMembershipProposal(suite.ctx, res.ProposalId)
+	suite.True(found)
+
+	expiredCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(params.ProposalLifetime))
+	_, err = msgServer.ApproveMembershipProposal(sdk.WrapSDKContext(expiredCtx), types.NewMsgApproveMembershipProposal(res.ProposalId, addrs[1]))
+	suite.Error(err)
+
+	guardian.EndBlocker(expiredCtx, suite.keeper)
+	_, found = suite.keeper.GetMembershipProposal(suite.ctx, res.ProposalId)
+	suite.False(found)
+
+	_, found = suite.keeper.GetSuper(suite.ctx, addrs[2])
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestApprovalThreshold() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis0", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis1", types.Genesis, addrs[1], addrs[1]))
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(3, time.Hour, 0, 70, false))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// the threshold is capped at the number of genesis supers
+	suite.Equal(uint32(2), suite.keeper.GetApprovalThreshold(suite.ctx))
+
+	res, err := msgServer.SubmitMembershipProposal(ctx, types.NewMsgSubmitMembershipProposal(types.ActionAddSuper, addrs[2], "ordinary", addrs[0]))
+	suite.NoError(err)
+	_, err = msgServer.ApproveMembershipProposal(ctx, types.NewMsgApproveMembershipProposal(res.ProposalId, addrs[1]))
+	suite.NoError(err)
+	_, err = msgServer.ExecuteMembershipProposal(ctx, types.NewMsgExecuteMembershipProposal(res.ProposalId, addrs[0]))
+	suite.NoError(err)
+	_, found := suite.keeper.GetSuper(suite.ctx, addrs[2])
+	suite.True(found)
+
+	// a single genesis super left is able to change the guardian set on its own
+	err = guardian.NewProposalHandler(suite.keeper)(suite.ctx, types.NewDeleteSuperProposal("title", "description", addrs[1]))
+	suite.NoError(err)
+	suite.Equal(uint32(1), suite.keeper.GetApprovalThreshold(suite.ctx))
+
+	_, err = msgServer.DeleteSuper(ctx, types.NewMsgDeleteSuper(addrs[2], addrs[0], false))
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestExpiredOperator() {
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := blockTime.Add(-time.Hour)
+
+	// an expired super which has not been pruned yet
+	expired := types.NewSuper("expired", types.Genesis, addrs[0], addrs[0])
+	expired.ExpiresAt = &expiresAt
+	suite.keeper.AddSuper(suite.ctx, expired)
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx.WithBlockTime(blockTime))
+
+	_, found := suite.keeper.GetActiveSuper(suite.ctx.WithBlockTime(blockTime), addrs[0])
+	suite.False(found)
+
+	_, err := msgServer.GrantScope(ctx, types.NewMsgGrantScope(addrs[1], types.ScopeOracle, addrs[0]))
+	suite.Error(err)
+	_, err = msgServer.RevokeScope(ctx, types.NewMsgRevokeScope(addrs[1], types.ScopeOracle, addrs[0]))
+	suite.Error(err)
+	_, err = msgServer.DeleteSuper(ctx, types.NewMsgDeleteSuper(addrs[1], addrs[0], false))
+	suite.Error(err)
+	_, err = msgServer.SubmitMembershipProposal(ctx, types.NewMsgSubmitMembershipProposal(types.ActionDeleteSuper, addrs[1], "", addrs[0]))
+	suite.Error(err)
+
+	_, found = suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.True(found)
+}
+
+func (suite *KeeperTestSuite) TestSuperProposals() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+
+	handler := guardian.NewProposalHandler(suite.keeper)
+
+	err := handler(suite.ctx, types.NewAddSuperProposal("title", "description", addrs[1], "genesis", types.Genesis))
+	suite.NoError(err)
+
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.True(found)
+	suite.Equal(types.Genesis, super.AccountType)
+	suite.Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), super.AddedBy)
+
+	err = handler(suite.ctx, types.NewAddSuperProposal("title", "description", addrs[1], "genesis", types.Genesis))
+	suite.Error(err)
+
+	// a genesis super can only be deleted by governance
+	err = handler(suite.ctx, types.NewDeleteSuperProposal("title", "description", addrs[0]))
+	suite.NoError(err)
+	_, found = suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.False(found)
+
+	err = handler(suite.ctx, types.NewDeleteSuperProposal("title", "description", addrs[2]))
+	suite.Error(err)
+
+	// the last genesis super can not be deleted
+	err = handler(suite.ctx, types.NewDeleteSuperProposal("title", "description", addrs[1]))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	invariant := keeper.AllInvariants(suite.keeper)
+
+	// the guardian set must not be empty
+	_, broken := keeper.GenesisSuperInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+	_, broken = keeper.GenesisSuperInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+	_, broken = keeper.AddedByInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	_, broken = invariant(suite.ctx)
+	suite.False(broken)
+
+	// a super added by a rotated super refers to its new address
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	_, err := msgServer.RotateSuper(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateSuper(addrs[0], addrs[2]))
+	suite.NoError(err)
+	_, broken = invariant(suite.ctx)
+	suite.False(broken)
+	ordinary, found := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.True(found)
+	suite.Equal(addrs[2].String(), ordinary.AddedBy)
+
+	bz := suite.app.AppCodec().MustMarshalBinaryBare(&types.Super{Address: addrs[1].String(), AddedBy: addrs[2].String()})
+	suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Set(types.GetSuperKey(addrs[0]), bz)
+	_, broken = keeper.SuperKeysInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *KeeperTestSuite) TestExpiringSupers() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	past := blockTime.Add(-time.Hour)
+	msg := types.NewMsgAddSuper("ordinary", addrs[1], addrs[0])
+	msg.ExpiresAt = &past
+	_, err := msgServer.AddSuper(sdk.WrapSDKContext(ctx), msg)
+	suite.Error(err)
+
+	expiresAt := blockTime.Add(time.Hour)
+	msg.ExpiresAt = &expiresAt
+	_, err = msgServer.AddSuper(sdk.WrapSDKContext(ctx), msg)
+	suite.NoError(err)
+
+	res, err := suite.keeper.ExpiringSupers(sdk.WrapSDKContext(ctx), &types.QueryExpiringSupersRequest{Within: 30 * time.Minute})
+	suite.NoError(err)
+	suite.Len(res.Supers, 0)
+
+	res, err = suite.keeper.ExpiringSupers(sdk.WrapSDKContext(ctx), &types.QueryExpiringSupersRequest{Within: time.Hour})
+	suite.NoError(err)
+	suite.Len(res.Supers, 1)
+	suite.Equal(addrs[1].String(), res.Supers[0].Address)
+
+	guardian.EndBlocker(ctx, suite.keeper)
+	suite.True(suite.keeper.Authorized(ctx, addrs[1]))
+
+	expiredCtx := ctx.WithBlockTime(expiresAt)
+	suite.False(suite.keeper.Authorized(expiredCtx, addrs[1]))
+
+	guardian.EndBlocker(expiredCtx, suite.keeper)
+	_, found := suite.keeper.GetSuper(expiredCtx, addrs[1])
+	suite.False(found)
+	_, found = suite.keeper.GetSuper(expiredCtx, addrs[0])
+	suite.True(found)
+
+	res, err = suite.keeper.ExpiringSupers(sdk.WrapSDKContext(expiredCtx), &types.QueryExpiringSupersRequest{Within: time.Hour})
+	suite.NoError(err)
+	suite.Len(res.Supers, 0)
+}
+
+func (suite *KeeperTestSuite) TestRotateSuper() {
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	genesis := types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0])
+	genesis.Scopes = []types.Scope{types.ScopeOracle}
+	genesis.ExpiresAt = &expiresAt
+	suite.keeper.AddSuper(suite.ctx, genesis)
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.RotateSuper(ctx, types.NewMsgRotateSuper(addrs[0], addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.RotateSuper(ctx, types.NewMsgRotateSuper(addrs[2], addrs[0]))
+	suite.Error(err)
+
+	// a super can not be rotated to a blocked account
+	suite.keeper.SetBlockedAccount(suite.ctx, types.NewBlockedAccount(addrs[2], "compromised", addrs[0]))
+	_, err = msgServer.RotateSuper(ctx, types.NewMsgRotateSuper(addrs[0], addrs[2]))
+	suite.ErrorIs(err, types.ErrAccountBlocked)
+	suite.keeper.DeleteBlockedAccount(suite.ctx, addrs[2])
+
+	_, err = msgServer.RotateSuper(ctx, types.NewMsgRotateSuper(addrs[0], addrs[2]))
+	suite.NoError(err)
+
+	_, found := suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.False(found)
+	super, found := suite.keeper.GetSuper(suite.ctx, addrs[2])
+	suite.True(found)
+	suite.Equal(addrs[2].String(), super.Address)
+	suite.Equal(genesis.Description, super.Description)
+	suite.Equal(genesis.AccountType, super.AccountType)
+	suite.Equal(genesis.Scopes, super.Scopes)
+
+	// the references to the previous address follow the super to the new address
+	suite.Equal(addrs[2].String(), super.AddedBy)
+	ordinary, found := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.True(found)
+	suite.Equal(addrs[2].String(), ordinary.AddedBy)
+
+	// the expiration index follows the super to the new address
+	var expiring []types.Super
+	suite.keeper.IterateExpiringSupers(suite.ctx, expiresAt, func(super types.Super) bool {
+		expiring = append(expiring, super)
+		return false
+	})
+	suite.Len(expiring, 1)
+	suite.Equal(addrs[2].String(), expiring[0].Address)
+
+	var history []types.HistoryEntry
+	suite.keeper.IterateHistory(suite.ctx, func(entry types.HistoryEntry) bool {
+		history = append(history, entry)
+		return false
+	})
+	suite.Len(history, 1)
+	suite.Equal(types.ActionRotateSuper, history[0].Action)
+	suite.Equal(addrs[2].String(), history[0].Address)
+	suite.Equal(addrs[0].String(), history[0].Operator)
+	suite.Equal(addrs[0].String(), history[0].PreviousAddress)
+
+	// the rotation is found through both addresses
+	for _, address := range []sdk.AccAddress{addrs[0], addrs[2]} {
+		res, err := suite.keeper.History(sdk.WrapSDKContext(suite.ctx), &types.QueryHistoryRequest{Address: address.String()})
+		suite.NoError(err)
+		suite.Len(res.History, 1)
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateSuper() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary1", types.Ordinary, addrs[1], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary2", types.Ordinary, addrs[2], addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// an ordinary super is able to update itself only
+	_, err := msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper("updated", addrs[2], addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper("updated", addrs[1], addrs[1]))
+	suite.NoError(err)
+	super, _ := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.Equal("updated", super.Description)
+
+	// a genesis super is able to update any super
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper("updated", addrs[2], addrs[0]))
+	suite.NoError(err)
+	super, _ = suite.keeper.GetSuper(suite.ctx, addrs[2])
+	suite.Equal("updated", super.Description)
+	suite.Equal(types.Ordinary, super.AccountType)
+	suite.Equal(addrs[0].String(), super.AddedBy)
+
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper("updated", addrs[0], addrs[0]))
+	suite.NoError(err)
+	super, _ = suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.Equal("updated", super.Description)
+}
+
+func (suite *KeeperTestSuite) TestTrustees() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// only genesis supers are able to add trustees
+	_, err := msgServer.AddTrustee(ctx, types.NewMsgAddTrustee("trustee", addrs[2], addrs[1]))
+	suite.Error(err)
+
+	// a lone genesis super is not able to add a trustee if the approval of several supers is required
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[1], addrs[1]))
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.ApprovalThreshold = 2
+	suite.keeper.SetParamSet(suite.ctx, params)
+	_, err = msgServer.AddTrustee(ctx, types.NewMsgAddTrustee("trustee", addrs[2], addrs[0]))
+	suite.ErrorIs(err, types.ErrApprovalRequired)
+	_, found := suite.keeper.GetTrustee(suite.ctx, addrs[2])
+	suite.False(found)
+
+	params.ApprovalThreshold = 1
+	suite.keeper.SetParamSet(suite.ctx, params)
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+	_, err = msgServer.AddTrustee(ctx, types.NewMsgAddTrustee("trustee", addrs[2], addrs[0]))
+	suite.NoError(err)
+	_, err = msgServer.AddTrustee(ctx, types.NewMsgAddTrustee("trustee", addrs[2], addrs[0]))
+	suite.Error(err)
+
+	trustee, found := suite.keeper.GetTrustee(suite.ctx, addrs[2])
+	suite.True(found)
+	suite.Equal(types.Ordinary, trustee.AccountType)
+	suite.Equal(addrs[0].String(), trustee.AddedBy)
+
+	// fund the community pool
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	suite.NoError(suite.app.MintKeeper.MintCoins(suite.ctx, amount))
+	suite.NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, addrs[0], amount))
+	suite.NoError(suite.app.DistrKeeper.FundCommunityPool(suite.ctx, amount, addrs[0]))
+
+	spend := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))
+	_, err = msgServer.SpendCommunityPool(ctx, types.NewMsgSpendCommunityPool(addrs[1], spend, addrs[0]))
+	suite.Error(err)
+	_, err = msgServer.SpendCommunityPool(ctx, types.NewMsgSpendCommunityPool(addrs[1], spend, addrs[2]))
+	suite.NoError(err)
+	suite.Equal(spend, suite.app.BankKeeper.GetAllBalances(suite.ctx, addrs[1]))
+
+	_, err = msgServer.SpendCommunityPool(ctx, types.NewMsgSpendCommunityPool(addrs[1], amount, addrs[2]))
+	suite.Error(err)
+
+	// blocked module accounts and blocked accounts are refused as recipients
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = msgServer.SpendCommunityPool(ctx, types.NewMsgSpendCommunityPool(govAddr, spend, addrs[2]))
+	suite.Error(err)
+	suite.True(suite.app.BankKeeper.GetAllBalances(suite.ctx, govAddr).IsZero())
+
+	blocked := sdk.AccAddress([]byte("blocked_____________"))
+	suite.keeper.SetBlockedAccount(suite.ctx, types.NewBlockedAccount(blocked, "compromised", addrs[0]))
+	_, err = msgServer.SpendCommunityPool(ctx, types.NewMsgSpendCommunityPool(blocked, spend, addrs[2]))
+	suite.ErrorIs(err, types.ErrAccountBlocked)
+	suite.True(suite.app.BankKeeper.GetAllBalances(suite.ctx, blocked).IsZero())
+
+	_, err = msgServer.DeleteTrustee(ctx, types.NewMsgDeleteTrustee(addrs[2], addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.DeleteTrustee(ctx, types.NewMsgDeleteTrustee(addrs[2], addrs[0]))
+	suite.NoError(err)
+	_, found = suite.keeper.GetTrustee(suite.ctx, addrs[2])
+	suite.False(found)
+
+	// genesis trustees can not be deleted
+	suite.keeper.AddTrustee(suite.ctx, types.NewTrustee("genesis", types.Genesis, addrs[2], addrs[0]))
+	_, err = msgServer.DeleteTrustee(ctx, types.NewMsgDeleteTrustee(addrs[2], addrs[0]))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCircuitBreaker() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	sendMsg := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	sendTypeURL := "/" + proto.MessageName(sendMsg)
+	decorator := keeper.NewCircuitBreakerDecorator(suite.keeper)
+	anteHandler := sdk.ChainAnteDecorators(decorator)
+	tx := mockTx{msgs: []sdk.Msg{sendMsg}}
+
+	// supers have to be authorized for the circuit breaker scope
+	_, err := msgServer.PauseMsgs(ctx, types.NewMsgPauseMsgs([]string{sendTypeURL}, 10, addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.PauseMsgs(ctx, types.NewMsgPauseMsgs([]string{sendTypeURL}, 5, addrs[0]))
+	suite.Error(err)
+
+	// the type urls have to refer to message types of the app
+	for _, typeURL := range []string{"/cosmos.bank.v1beta1.MsgSnd", "/cosmos.crypto.secp256k1.PubKey"} {
+		_, err = msgServer.PauseMsgs(ctx, types.NewMsgPauseMsgs([]string{sendTypeURL, typeURL}, 10, addrs[0]))
+		suite.ErrorIs(err, types.ErrInvalidTypeURL, typeURL)
+		suite.False(suite.keeper.IsMsgPaused(suite.ctx, sendTypeURL))
+	}
+
+	_, err = msgServer.PauseMsgs(ctx, types.NewMsgPauseMsgs([]string{sendTypeURL}, 10, addrs[0]))
+	suite.NoError(err)
+
+	suite.True(suite.keeper.IsMsgPaused(suite.ctx, sendTypeURL))
+	_, err = anteHandler(suite.ctx, tx, false)
+	suite.Error(err)
+
+	res, err := suite.keeper.PausedMsgs(ctx, &types.QueryPausedMsgsRequest{})
+	suite.NoError(err)
+	suite.Equal([]types.PausedMsg{types.NewPausedMsg(sendTypeURL, 10, addrs[0].String())}, res.PausedMsgs)
+
+	// the message type is enabled again at the expiry height
+	expiredCtx := suite.ctx.WithBlockHeight(10)
+	suite.False(suite.keeper.IsMsgPaused(expiredCtx, sendTypeURL))
+	_, err = anteHandler(expiredCtx, tx, false)
+	suite.NoError(err)
+	guardian.EndBlocker(expiredCtx, suite.keeper)
+	_, found := suite.keeper.GetPausedMsg(suite.ctx, sendTypeURL)
+	suite.False(found)
+
+	// pause without expiry and unpause manually
+	_, err = msgServer.UnpauseMsgs(ctx, types.NewMsgUnpauseMsgs([]string{sendTypeURL}, addrs[0]))
+	suite.Error(err)
+	_, err = msgServer.PauseMsgs(ctx, types.NewMsgPauseMsgs([]string{sendTypeURL}, 0, addrs[0]))
+	suite.NoError(err)
+	guardian.EndBlocker(suite.ctx.WithBlockHeight(1000), suite.keeper)
+	suite.True(suite.keeper.IsMsgPaused(suite.ctx, sendTypeURL))
+
+	_, err = msgServer.GrantScope(ctx, types.NewMsgGrantScope(addrs[1], types.ScopeCircuitBreaker, addrs[0]))
+	suite.NoError(err)
+	_, err = msgServer.UnpauseMsgs(ctx, types.NewMsgUnpauseMsgs([]string{sendTypeURL}, addrs[1]))
+	suite.NoError(err)
+	suite.False(suite.keeper.IsMsgPaused(suite.ctx, sendTypeURL))
+	_, err = anteHandler(suite.ctx, tx, false)
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestBlocklist() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	anteHandler := sdk.ChainAnteDecorators(keeper.NewBlocklistDecorator(suite.keeper))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+
+	// supers have to be authorized for the blocklist scope and can not be blocked themselves
+	_, err := msgServer.BlockAccount(ctx, types.NewMsgBlockAccount(addrs[2], "compromised", addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.BlockAccount(ctx, types.NewMsgBlockAccount(addrs[1], "compromised", addrs[0]))
+	suite.Error(err)
+	eventCtx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.BlockAccount(sdk.WrapSDKContext(eventCtx), types.NewMsgBlockAccount(addrs[2], "compromised", addrs[0]))
+	suite.NoError(err)
+	suite.Equal(addrs[2].String(), blocklistEventAccount(eventCtx, types.EventTypeBlockAccount))
+	_, err = msgServer.BlockAccount(ctx, types.NewMsgBlockAccount(addrs[2], "compromised", addrs[0]))
+	suite.Error(err)
+
+	res, err := suite.keeper.BlockedAccounts(ctx, &types.QueryBlockedAccountsRequest{})
+	suite.NoError(err)
+	suite.Equal([]types.BlockedAccount{types.NewBlockedAccount(addrs[2], "compromised", addrs[0])}, res.BlockedAccounts)
+
+	// blocked accounts are neither able to sign nor to receive transfers
+	_, err = anteHandler(suite.ctx, mockTx{msgs: []sdk.Msg{banktypes.NewMsgSend(addrs[2], addrs[1], coins)}}, false)
+	suite.Error(err)
+	_, err = anteHandler(suite.ctx, mockTx{msgs: []sdk.Msg{banktypes.NewMsgSend(addrs[1], addrs[2], coins)}}, false)
+	suite.Error(err)
+	multiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(addrs[1], coins.Add(coins...))},
+		[]banktypes.Output{banktypes.NewOutput(addrs[0], coins), banktypes.NewOutput(addrs[2], coins)},
+	)
+	_, err = anteHandler(suite.ctx, mockTx{msgs: []sdk.Msg{multiSend}}, false)
+	suite.Error(err)
+
+	// the receiver of an ibc transfer is matched regardless of its prefix
+	receiver, err := bech32.ConvertAndEncode("cosmos", addrs[2])
+	suite.NoError(err)
+	transfer := ibctransfertypes.NewMsgTransfer("transfer", "channel-0", coins[0], addrs[1], receiver, clienttypes.NewHeight(0, 100), 0)
+	_, err = anteHandler(suite.ctx, mockTx{msgs: []sdk.Msg{transfer}}, false)
+	suite.Error(err)
+
+	_, err = anteHandler(suite.ctx, mockTx{msgs: []sdk.Msg{banktypes.NewMsgSend(addrs[1], addrs[0], coins)}}, false)
+	suite.NoError(err)
+
+	_, err = msgServer.UnblockAccount(ctx, types.NewMsgUnblockAccount(addrs[2], addrs[1]))
+	suite.Error(err)
+	eventCtx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UnblockAccount(sdk.WrapSDKContext(eventCtx), types.NewMsgUnblockAccount(addrs[2], addrs[0]))
+	suite.NoError(err)
+	suite.Equal(addrs[2].String(), blocklistEventAccount(eventCtx, types.EventTypeUnblockAccount))
+	_, err = msgServer.UnblockAccount(ctx, types.NewMsgUnblockAccount(addrs[2], addrs[0]))
+	suite.Error(err)
+	suite.False(suite.keeper.IsBlocked(suite.ctx, addrs[2]))
+
+	_, err = anteHandler(suite.ctx, mockTx{msgs: []sdk.Msg{transfer}}, false)
+	suite.NoError(err)
+}
+
+// blocklistEventAccount returns the account attribute of the emitted event of the given type
+func blocklistEventAccount(ctx sdk.Context, eventType string) string {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyAccount {
+				return string(attr.Value)
+			}
+		}
+	}
+	return ""
+}
+
+func (suite *KeeperTestSuite) TestAddBlockedSuper() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis0", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.SetBlockedAccount(suite.ctx, types.NewBlockedAccount(addrs[1], "compromised", addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.AddSuper(ctx, types.NewMsgAddSuper("ordinary", addrs[1], addrs[0]))
+	suite.ErrorIs(err, types.ErrAccountBlocked)
+
+	handler := guardian.NewProposalHandler(suite.keeper)
+	err = handler(suite.ctx, types.NewAddSuperProposal("title", "description", addrs[1], "genesis", types.Genesis))
+	suite.ErrorIs(err, types.ErrAccountBlocked)
+
+	// an approved membership proposal can not add an account blocked after its submission
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis1", types.Genesis, addrs[2], addrs[2]))
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(2, time.Hour, 0, 70, false))
+
+	_, err = msgServer.SubmitMembershipProposal(ctx, types.NewMsgSubmitMembershipProposal(types.ActionAddSuper, addrs[1], "ordinary", addrs[0]))
+	suite.ErrorIs(err, types.ErrAccountBlocked)
+
+	suite.keeper.DeleteBlockedAccount(suite.ctx, addrs[1])
+	res, err := msgServer.SubmitMembershipProposal(ctx, types.NewMsgSubmitMembershipProposal(types.ActionAddSuper, addrs[1], "ordinary", addrs[0]))
+	suite.NoError(err)
+	_, err = msgServer.ApproveMembershipProposal(ctx, types.NewMsgApproveMembershipProposal(res.ProposalId, addrs[2]))
+	suite.NoError(err)
+
+	suite.keeper.SetBlockedAccount(suite.ctx, types.NewBlockedAccount(addrs[1], "compromised", addrs[0]))
+	_, err = msgServer.ExecuteMembershipProposal(ctx, types.NewMsgExecuteMembershipProposal(res.ProposalId, addrs[2]))
+	suite.ErrorIs(err, types.ErrAccountBlocked)
+
+	_, found := suite.keeper.GetSuper(suite.ctx, addrs[1])
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestHooks() {
+	hooks := &mockHooks{}
+	k := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetSubspace(types.ModuleName), suite.app.InterfaceRegistry(),
+		suite.app.BankKeeper, suite.app.DistrKeeper,
+	)
+	k.SetHooks(types.NewMultiGuardianHooks(hooks))
+	suite.Panics(func() { k.SetHooks(types.NewMultiGuardianHooks()) })
+
+	genesis := guardian.ExportGenesis(suite.ctx, k)
+	genesis.Supers = []types.Super{types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0])}
+	guardian.InitGenesis(suite.ctx, k, *genesis)
+	suite.Equal([]string{addrs[0].String()}, hooks.added)
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := msgServer.AddSuper(ctx, types.NewMsgAddSuper("ordinary", addrs[1], addrs[0]))
+	suite.NoError(err)
+	_, err = msgServer.RotateSuper(ctx, types.NewMsgRotateSuper(addrs[1], addrs[2]))
+	suite.NoError(err)
+	_, err = msgServer.DeleteSuper(ctx, types.NewMsgDeleteSuper(addrs[2], addrs[0], false))
+	suite.NoError(err)
+
+	// failed messages don't call the hooks
+	_, err = msgServer.DeleteSuper(ctx, types.NewMsgDeleteSuper(addrs[2], addrs[0], false))
+	suite.Error(err)
+
+	suite.Equal([]string{addrs[0].String(), addrs[1].String(), addrs[2].String()}, hooks.added)
+	suite.Equal([]string{addrs[1].String(), addrs[2].String()}, hooks.removed)
+}
+
+func (suite *KeeperTestSuite) TestParamLimits() {
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("ordinary", types.Ordinary, addrs[1], addrs[0]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// ordinary supers are not able to add supers by default
+	_, err := msgServer.AddSuper(ctx, types.NewMsgAddSuper("new", addrs[2], addrs[1]))
+	suite.Error(err)
+
+	suite.keeper.SetParamSet(suite.ctx, types.NewParams(1, time.Hour, 3, 10, true))
+
+	_, err = msgServer.AddSuper(ctx, types.NewMsgAddSuper("description", addrs[2], addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.UpdateSuper(ctx, types.NewMsgUpdateSuper("description", addrs[1], addrs[1]))
+	suite.Error(err)
+	_, err = msgServer.AddSuper(ctx, types.NewMsgAddSuper("new", addrs[2], addrs[1]))
+	suite.NoError(err)
+
+	// the guardian set is full
+	newAddr := sdk.AccAddress([]byte("addr4_______________"))
+	_, err = msgServer.AddSuper(ctx, types.NewMsgAddSuper("new", newAddr, addrs[0]))
+	suite.Error(err)
+	proposal := types.NewAddSuperProposal("title", "description", newAddr, "new", types.Ordinary)
+	suite.Error(keeper.HandleAddSuperProposal(suite.ctx, suite.keeper, proposal))
+	_, err = msgServer.SubmitMembershipProposal(ctx, types.NewMsgSubmitMembershipProposal(types.ActionAddSuper, newAddr, "new", addrs[0]))
+	suite.Error(err)
+
+	_, err = msgServer.DeleteSuper(ctx, types.NewMsgDeleteSuper(addrs[2], addrs[0], false))
+	suite.NoError(err)
+	suite.NoError(keeper.HandleAddSuperProposal(suite.ctx, suite.keeper, proposal))
+}
+
+func (suite *KeeperTestSuite) TestCascadeDeleteSuper() {
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+	addr5 := sdk.AccAddress([]byte("addr5_______________"))
+	addr6 := sdk.AccAddress([]byte("addr6_______________"))
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("root", types.Ordinary, addrs[1], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("child", types.Ordinary, addrs[2], addrs[1]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("grandchild", types.Ordinary, addr4, addrs[2]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("sibling", types.Ordinary, addr5, addrs[0]))
+	// genesis supers are never removed by a cascade
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addr6, addrs[1]))
+
+	res, err := suite.keeper.SuperDescendants(sdk.WrapSDKContext(suite.ctx), &types.QuerySuperDescendantsRequest{Address: addrs[1].String()})
+	suite.NoError(err)
+	suite.Len(res.Supers, 2)
+	suite.Equal(addrs[2].String(), res.Supers[0].Address)
+	suite.Equal(addr4.String(), res.Supers[1].Address)
+
+	_, err = suite.keeper.SuperDescendants(sdk.WrapSDKContext(suite.ctx), &types.QuerySuperDescendantsRequest{Address: sdk.AccAddress([]byte("unknown_____________")).String()})
+	suite.Error(err)
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.DeleteSuper(sdk.WrapSDKContext(ctx), types.NewMsgDeleteSuper(addrs[1], addrs[0], true))
+	suite.NoError(err)
+
+	var deleted []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeDeleteSuper {
+			deleted = append(deleted, string(event.Attributes[0].Value))
+		}
+	}
+	suite.Equal([]string{addrs[1].String(), addrs[2].String(), addr4.String()}, deleted)
+
+	for _, addr := range []sdk.AccAddress{addrs[1], addrs[2], addr4} {
+		_, found := suite.keeper.GetSuper(suite.ctx, addr)
+		suite.False(found)
+	}
+	for _, addr := range []sdk.AccAddress{addrs[0], addr5, addr6} {
+		_, found := suite.keeper.GetSuper(suite.ctx, addr)
+		suite.True(found)
+	}
+}
+
+func (suite *KeeperTestSuite) TestRotateSuperDescendants() {
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+	addr5 := sdk.AccAddress([]byte("addr5_______________"))
+
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, addrs[0], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("root", types.Ordinary, addrs[1], addrs[0]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("child", types.Ordinary, addrs[2], addrs[1]))
+	suite.keeper.AddSuper(suite.ctx, types.NewSuper("grandchild", types.Ordinary, addr4, addrs[2]))
+
+	msgServer := keeper.NewMsgServerImpl(suite.keeper)
+	_, err := msgServer.RotateSuper(sdk.WrapSDKContext(suite.ctx), types.NewMsgRotateSuper(addrs[1], addr5))
+	suite.NoError(err)
+
+	// the children of the rotated super are found through its new address
+	descendants := suite.keeper.GetSuperDescendants(suite.ctx, addr5)
+	suite.Len(descendants, 2)
+	suite.Equal(addrs[2].String(), descendants[0].Address)
+	suite.Equal(addr5.String(), descendants[0].AddedBy)
+	suite.Equal(addr4.String(), descendants[1].Address)
+	suite.Empty(suite.keeper.GetSuperDescendants(suite.ctx, addrs[1]))
+
+	_, err = msgServer.DeleteSuper(sdk.WrapSDKContext(suite.ctx), types.NewMsgDeleteSuper(addr5, addrs[0], true))
+	suite.NoError(err)
+
+	for _, addr := range []sdk.AccAddress{addr5, addrs[2], addr4} {
+		_, found := suite.keeper.GetSuper(suite.ctx, addr)
+		suite.False(found)
+	}
+	_, found := suite.keeper.GetSuper(suite.ctx, addrs[0])
+	suite.True(found)
+}
+
+// mockHooks records the addresses passed to the guardian hooks
+type mockHooks struct {
+	added   []string
+	removed []string
+}
+
+func (h *mockHooks) AfterSuperAdded(_ sdk.Context, address sdk.AccAddress) {
+	h.added = append(h.added, address.String())
+}
+
+func (h *mockHooks) AfterSuperRemoved(_ sdk.Context, address sdk.AccAddress) {
+	h.removed = append(h.removed, address.String())
+}
+
+// mockTx is a minimal sdk.Tx carrying the given messages
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
 func newPubKey(pk string) (res cryptotypes.PubKey) {
 	pkBytes, err := hex.DecodeString(pk)
 	if err != nil {
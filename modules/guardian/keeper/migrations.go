@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// MigrateScopes grants the oracle scope to the ordinary supers of a running chain, which
+// were authorized for the oracle before scopes existed
+func (k Keeper) MigrateScopes(ctx sdk.Context) {
+	var supers []types.Super
+	k.IterateSupers(
+		ctx,
+		func(super types.Super) bool {
+			if super.AccountType == types.Ordinary && !super.HasScope(types.ScopeOracle) {
+				supers = append(supers, super)
+			}
+			return false
+		},
+	)
+
+	for _, super := range supers {
+		super.Scopes = append(super.Scopes, types.ScopeOracle)
+		k.AddSuper(ctx, super)
+	}
+}
+
+// MigrateParams sets the params which are missing from the store of a running chain to their defaults
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
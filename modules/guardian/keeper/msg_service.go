@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -31,14 +33,47 @@ func (m msgServer) AddSuper(goCtx context.Context, msg *types.MsgAddSuper) (*typ
 	if err != nil {
 		return nil, err
 	}
-	if super, found := m.Keeper.GetSuper(ctx, addedBy); !found || super.GetAccountType() != types.Genesis {
+	params := m.Keeper.GetParamSet(ctx)
+	// ordinary supers are only able to add supers if the params allow it
+	operator, found := m.Keeper.GetActiveSuper(ctx, addedBy)
+	if !found ||
+		(operator.GetAccountType() != types.Genesis && !params.OrdinaryCanAddSupers) {
 		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.AddedBy)
 	}
+	if m.Keeper.GetApprovalThreshold(ctx) > 1 {
+		return nil, sdkerrors.Wrap(types.ErrApprovalRequired, msg.Address)
+	}
 	if _, found := m.Keeper.GetSuper(ctx, address); found {
 		return nil, sdkerrors.Wrap(types.ErrSuperExists, msg.Address)
 	}
+	if m.Keeper.IsBlocked(ctx, address) {
+		return nil, sdkerrors.Wrap(types.ErrAccountBlocked, msg.Address)
+	}
+	if err := m.Keeper.ValidateSuperCapacity(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.ValidateDescription(ctx, msg.Description); err != nil {
+		return nil, err
+	}
+	if msg.ExpiresAt != nil && !msg.ExpiresAt.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiration, "expiration time %s must be after the block time", msg.ExpiresAt)
+	}
 	super := types.NewSuper(msg.Description, types.Ordinary, address, addedBy)
+	super.ExpiresAt = msg.ExpiresAt
 	m.Keeper.AddSuper(ctx, super)
+	m.Keeper.AppendHistory(ctx, types.ActionAddSuper, super, msg.AddedBy)
+	m.Keeper.AfterSuperAdded(ctx, address)
+
+	addSuperEvent := sdk.NewEvent(
+		types.EventTypeAddSuper,
+		sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+		sdk.NewAttribute(types.AttributeKeyAddedBy, msg.AddedBy),
+	)
+	if msg.ExpiresAt != nil {
+		addSuperEvent = addSuperEvent.AppendAttributes(
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, msg.ExpiresAt.Format(time.RFC3339)),
+		)
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -46,11 +81,7 @@ func (m msgServer) AddSuper(goCtx context.Context, msg *types.MsgAddSuper) (*typ
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.AddedBy),
 		),
-		sdk.NewEvent(
-			types.EventTypeAddSuper,
-			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
-			sdk.NewAttribute(types.AttributeKeyAddedBy, msg.AddedBy),
-		),
+		addSuperEvent,
 	})
 
 	return &types.MsgAddSuperResponse{}, nil
@@ -68,9 +99,12 @@ func (m msgServer) DeleteSuper(goCtx context.Context, msg *types.MsgDeleteSuper)
 		return nil, err
 	}
 
-	if super, found := m.Keeper.GetSuper(ctx, deletedBy); !found || super.GetAccountType() != types.Genesis {
+	if super, found := m.Keeper.GetActiveSuper(ctx, deletedBy); !found || super.GetAccountType() != types.Genesis {
 		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.DeletedBy)
 	}
+	if m.Keeper.GetApprovalThreshold(ctx) > 1 {
+		return nil, sdkerrors.Wrap(types.ErrApprovalRequired, msg.Address)
+	}
 	super, found := m.Keeper.GetSuper(ctx, address)
 	if !found {
 		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, msg.Address)
@@ -79,9 +113,17 @@ func (m msgServer) DeleteSuper(goCtx context.Context, msg *types.MsgDeleteSuper)
 		return nil, sdkerrors.Wrap(types.ErrDeleteGenesisSuper, msg.Address)
 	}
 
+	// the descendants must be collected before the super is deleted
+	var descendants []types.Super
+	if msg.Cascade {
+		descendants = m.Keeper.GetSuperDescendants(ctx, address)
+	}
+
 	m.Keeper.DeleteSuper(ctx, address)
+	m.Keeper.AppendHistory(ctx, types.ActionDeleteSuper, super, msg.DeletedBy)
+	m.Keeper.AfterSuperRemoved(ctx, address)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
+	events := sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -92,7 +134,655 @@ func (m msgServer) DeleteSuper(goCtx context.Context, msg *types.MsgDeleteSuper)
 			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
 			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.DeletedBy),
 		),
-	})
+	}
+
+	for _, descendant := range descendants {
+		descendantAddr, _ := sdk.AccAddressFromBech32(descendant.Address)
+		m.Keeper.DeleteSuper(ctx, descendantAddr)
+		m.Keeper.AppendHistory(ctx, types.ActionDeleteSuper, descendant, msg.DeletedBy)
+		m.Keeper.AfterSuperRemoved(ctx, descendantAddr)
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDeleteSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, descendant.Address),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.DeletedBy),
+			sdk.NewAttribute(types.AttributeKeyCascadedFrom, msg.Address),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(events)
 
 	return &types.MsgDeleteSuperResponse{}, nil
 }
+
+func (m msgServer) GrantScope(goCtx context.Context, msg *types.MsgGrantScope) (*types.MsgGrantScopeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantedBy, err := sdk.AccAddressFromBech32(msg.GrantedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if super, found := m.Keeper.GetActiveSuper(ctx, grantedBy); !found || super.GetAccountType() != types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.GrantedBy)
+	}
+	super, found := m.Keeper.GetSuper(ctx, address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, msg.Address)
+	}
+	if super.GetAccountType() == types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrGenesisSuperScope, msg.Address)
+	}
+	if super.HasScope(msg.Scope) {
+		return nil, sdkerrors.Wrapf(types.ErrScopeExists, "%s: %s", msg.Address, msg.Scope)
+	}
+
+	super.Scopes = append(super.Scopes, msg.Scope)
+	m.Keeper.AddSuper(ctx, super)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GrantedBy),
+		),
+		sdk.NewEvent(
+			types.EventTypeGrantScope,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyScope, msg.Scope.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantedBy, msg.GrantedBy),
+		),
+	})
+
+	return &types.MsgGrantScopeResponse{}, nil
+}
+
+func (m msgServer) RevokeScope(goCtx context.Context, msg *types.MsgRevokeScope) (*types.MsgRevokeScopeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	revokedBy, err := sdk.AccAddressFromBech32(msg.RevokedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if super, found := m.Keeper.GetActiveSuper(ctx, revokedBy); !found || super.GetAccountType() != types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.RevokedBy)
+	}
+	super, found := m.Keeper.GetSuper(ctx, address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, msg.Address)
+	}
+	if super.GetAccountType() == types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrGenesisSuperScope, msg.Address)
+	}
+
+	scopes := make([]types.Scope, 0, len(super.Scopes))
+	for _, scope := range super.Scopes {
+		if scope != msg.Scope {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == len(super.Scopes) {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownScope, "%s: %s", msg.Address, msg.Scope)
+	}
+
+	super.Scopes = scopes
+	m.Keeper.AddSuper(ctx, super)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.RevokedBy),
+		),
+		sdk.NewEvent(
+			types.EventTypeRevokeScope,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyScope, msg.Scope.String()),
+			sdk.NewAttribute(types.AttributeKeyRevokedBy, msg.RevokedBy),
+		),
+	})
+
+	return &types.MsgRevokeScopeResponse{}, nil
+}
+
+func (m msgServer) SubmitMembershipProposal(goCtx context.Context, msg *types.MsgSubmitMembershipProposal) (*types.MsgSubmitMembershipProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if super, found := m.Keeper.GetActiveSuper(ctx, proposer); !found || super.GetAccountType() != types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Proposer)
+	}
+	if err := m.Keeper.ValidateMembershipChange(ctx, msg.Action, address); err != nil {
+		return nil, err
+	}
+	if msg.Action == types.ActionAddSuper {
+		if err := m.Keeper.ValidateDescription(ctx, msg.Description); err != nil {
+			return nil, err
+		}
+	}
+
+	params := m.Keeper.GetParamSet(ctx)
+	proposalID := m.Keeper.GetNextProposalID(ctx)
+
+	m.Keeper.SetMembershipProposal(ctx, types.MembershipProposal{
+		Id:          proposalID,
+		Action:      msg.Action,
+		Address:     msg.Address,
+		Description: msg.Description,
+		Proposer:    msg.Proposer,
+		Approvals:   []string{msg.Proposer},
+		ExpireTime:  ctx.BlockTime().Add(params.ProposalLifetime),
+	})
+	m.Keeper.SetNextProposalID(ctx, proposalID+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Proposer),
+		),
+		sdk.NewEvent(
+			types.EventTypeSubmitMembershipProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAction, msg.Action.String()),
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+		),
+	})
+
+	return &types.MsgSubmitMembershipProposalResponse{ProposalId: proposalID}, nil
+}
+
+func (m msgServer) ApproveMembershipProposal(goCtx context.Context, msg *types.MsgApproveMembershipProposal) (*types.MsgApproveMembershipProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	approver, err := sdk.AccAddressFromBech32(msg.Approver)
+	if err != nil {
+		return nil, err
+	}
+
+	if super, found := m.Keeper.GetActiveSuper(ctx, approver); !found || super.GetAccountType() != types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Approver)
+	}
+	proposal, err := m.Keeper.getActiveMembershipProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.HasApproved(msg.Approver) {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyApproved, "%s: %d", msg.Approver, msg.ProposalId)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, msg.Approver)
+	m.Keeper.SetMembershipProposal(ctx, proposal)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Approver),
+		),
+		sdk.NewEvent(
+			types.EventTypeApproveMembershipProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyApprover, msg.Approver),
+		),
+	})
+
+	return &types.MsgApproveMembershipProposalResponse{}, nil
+}
+
+func (m msgServer) ExecuteMembershipProposal(goCtx context.Context, msg *types.MsgExecuteMembershipProposal) (*types.MsgExecuteMembershipProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	executor, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		return nil, err
+	}
+
+	if super, found := m.Keeper.GetActiveSuper(ctx, executor); !found || super.GetAccountType() != types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.Executor)
+	}
+	proposal, err := m.Keeper.getActiveMembershipProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	threshold := m.Keeper.GetApprovalThreshold(ctx)
+	if approvals := m.Keeper.CountApprovals(ctx, proposal); approvals < threshold {
+		return nil, sdkerrors.Wrapf(types.ErrNotEnoughApprovals, "got: %d, required: %d", approvals, threshold)
+	}
+
+	address, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.ValidateMembershipChange(ctx, proposal.Action, address); err != nil {
+		return nil, err
+	}
+
+	var event sdk.Event
+	switch proposal.Action {
+	case types.ActionAddSuper:
+		proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+		if err != nil {
+			return nil, err
+		}
+		super := types.NewSuper(proposal.Description, types.Ordinary, address, proposer)
+		m.Keeper.AddSuper(ctx, super)
+		m.Keeper.AppendHistory(ctx, types.ActionAddSuper, super, proposal.Proposer)
+		m.Keeper.AfterSuperAdded(ctx, address)
+		event = sdk.NewEvent(
+			types.EventTypeAddSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, proposal.Address),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, proposal.Proposer),
+		)
+	case types.ActionDeleteSuper:
+		super, _ := m.Keeper.GetSuper(ctx, address)
+		m.Keeper.DeleteSuper(ctx, address)
+		m.Keeper.AppendHistory(ctx, types.ActionDeleteSuper, super, proposal.Proposer)
+		m.Keeper.AfterSuperRemoved(ctx, address)
+		event = sdk.NewEvent(
+			types.EventTypeDeleteSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, proposal.Address),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, proposal.Proposer),
+		)
+	}
+	m.Keeper.DeleteMembershipProposal(ctx, proposal.Id)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Executor),
+		),
+		event,
+		sdk.NewEvent(
+			types.EventTypeExecuteMembershipProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyExecutor, msg.Executor),
+		),
+	})
+
+	return &types.MsgExecuteMembershipProposalResponse{}, nil
+}
+
+func (m msgServer) AddTrustee(goCtx context.Context, msg *types.MsgAddTrustee) (*types.MsgAddTrusteeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addedBy, err := sdk.AccAddressFromBech32(msg.AddedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if super, found := m.Keeper.GetActiveSuper(ctx, addedBy); !found || super.GetAccountType() != types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.AddedBy)
+	}
+	// a trustee is able to spend the community pool, so a single genesis super must not add one
+	// when membership changes require the approval of several supers
+	if m.Keeper.GetApprovalThreshold(ctx) > 1 {
+		return nil, sdkerrors.Wrap(types.ErrApprovalRequired, msg.Address)
+	}
+	if _, found := m.Keeper.GetTrustee(ctx, address); found {
+		return nil, sdkerrors.Wrap(types.ErrTrusteeExists, msg.Address)
+	}
+	if err := m.Keeper.ValidateDescription(ctx, msg.Description); err != nil {
+		return nil, err
+	}
+	m.Keeper.AddTrustee(ctx, types.NewTrustee(msg.Description, types.Ordinary, address, addedBy))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.AddedBy),
+		),
+		sdk.NewEvent(
+			types.EventTypeAddTrustee,
+			sdk.NewAttribute(types.AttributeKeyTrustee, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, msg.AddedBy),
+		),
+	})
+
+	return &types.MsgAddTrusteeResponse{}, nil
+}
+
+func (m msgServer) DeleteTrustee(goCtx context.Context, msg *types.MsgDeleteTrustee) (*types.MsgDeleteTrusteeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deletedBy, err := sdk.AccAddressFromBech32(msg.DeletedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if super, found := m.Keeper.GetActiveSuper(ctx, deletedBy); !found || super.GetAccountType() != types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.DeletedBy)
+	}
+	trustee, found := m.Keeper.GetTrustee(ctx, address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownTrustee, msg.Address)
+	}
+	if trustee.GetAccountType() == types.Genesis {
+		return nil, sdkerrors.Wrap(types.ErrDeleteGenesisTrustee, msg.Address)
+	}
+
+	m.Keeper.DeleteTrustee(ctx, address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeletedBy),
+		),
+		sdk.NewEvent(
+			types.EventTypeDeleteTrustee,
+			sdk.NewAttribute(types.AttributeKeyTrustee, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, msg.DeletedBy),
+		),
+	})
+
+	return &types.MsgDeleteTrusteeResponse{}, nil
+}
+
+func (m msgServer) SpendCommunityPool(goCtx context.Context, msg *types.MsgSpendCommunityPool) (*types.MsgSpendCommunityPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	trustee, err := sdk.AccAddressFromBech32(msg.Trustee)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := m.Keeper.GetTrustee(ctx, trustee); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownTrustee, msg.Trustee)
+	}
+	if err := m.Keeper.SpendCommunityPool(ctx, msg.Amount, recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Trustee),
+		),
+		sdk.NewEvent(
+			types.EventTypeSpendCommunityPool,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTrustee, msg.Trustee),
+		),
+	})
+
+	return &types.MsgSpendCommunityPoolResponse{}, nil
+}
+
+func (m msgServer) RotateSuper(goCtx context.Context, msg *types.MsgRotateSuper) (*types.MsgRotateSuperResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	newAddress, err := sdk.AccAddressFromBech32(msg.NewAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	super, found := m.Keeper.GetActiveSuper(ctx, address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, msg.Address)
+	}
+	if _, found := m.Keeper.GetSuper(ctx, newAddress); found {
+		return nil, sdkerrors.Wrap(types.ErrSuperExists, msg.NewAddress)
+	}
+	if m.Keeper.IsBlocked(ctx, newAddress) {
+		return nil, sdkerrors.Wrap(types.ErrAccountBlocked, msg.NewAddress)
+	}
+
+	rotated := m.Keeper.RotateSuper(ctx, super, newAddress)
+	m.Keeper.AppendRotationHistory(ctx, rotated, msg.Address, msg.Address)
+	m.Keeper.AfterSuperRemoved(ctx, address)
+	m.Keeper.AfterSuperAdded(ctx, newAddress)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+		sdk.NewEvent(
+			types.EventTypeRotateSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyNewAddress, msg.NewAddress),
+		),
+	})
+
+	return &types.MsgRotateSuperResponse{}, nil
+}
+
+func (m msgServer) UpdateSuper(goCtx context.Context, msg *types.MsgUpdateSuper) (*types.MsgUpdateSuperResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	updatedBy, err := sdk.AccAddressFromBech32(msg.UpdatedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// a super is able to update itself while a genesis super is able to update any super
+	operator, found := m.Keeper.GetActiveSuper(ctx, updatedBy)
+	if !found ||
+		(!updatedBy.Equals(address) && operator.GetAccountType() != types.Genesis) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.UpdatedBy)
+	}
+	super, found := m.Keeper.GetSuper(ctx, address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, msg.Address)
+	}
+
+	if err := m.Keeper.ValidateDescription(ctx, msg.Description); err != nil {
+		return nil, err
+	}
+
+	super.Description = msg.Description
+	m.Keeper.AddSuper(ctx, super)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.UpdatedBy),
+		),
+		sdk.NewEvent(
+			types.EventTypeUpdateSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyDescription, msg.Description),
+			sdk.NewAttribute(types.AttributeKeyUpdatedBy, msg.UpdatedBy),
+		),
+	})
+
+	return &types.MsgUpdateSuperResponse{}, nil
+}
+
+func (m msgServer) PauseMsgs(goCtx context.Context, msg *types.MsgPauseMsgs) (*types.MsgPauseMsgsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pausedBy, err := sdk.AccAddressFromBech32(msg.PausedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.AuthorizedFor(ctx, pausedBy, types.ScopeCircuitBreaker) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.PausedBy)
+	}
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiration, "expiry height %d must be after the current height", msg.ExpiryHeight)
+	}
+	for _, typeURL := range msg.TypeUrls {
+		if err := m.Keeper.ValidateMsgTypeURL(typeURL); err != nil {
+			return nil, err
+		}
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.PausedBy),
+		),
+	}
+	for _, typeURL := range msg.TypeUrls {
+		m.Keeper.SetPausedMsg(ctx, types.NewPausedMsg(typeURL, msg.ExpiryHeight, msg.PausedBy))
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypePauseMsg,
+			sdk.NewAttribute(types.AttributeKeyTypeURL, typeURL),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, fmt.Sprintf("%d", msg.ExpiryHeight)),
+			sdk.NewAttribute(types.AttributeKeyPausedBy, msg.PausedBy),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgPauseMsgsResponse{}, nil
+}
+
+func (m msgServer) UnpauseMsgs(goCtx context.Context, msg *types.MsgUnpauseMsgs) (*types.MsgUnpauseMsgsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unpausedBy, err := sdk.AccAddressFromBech32(msg.UnpausedBy)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.AuthorizedFor(ctx, unpausedBy, types.ScopeCircuitBreaker) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.UnpausedBy)
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.UnpausedBy),
+		),
+	}
+	for _, typeURL := range msg.TypeUrls {
+		if _, found := m.Keeper.GetPausedMsg(ctx, typeURL); !found {
+			return nil, sdkerrors.Wrap(types.ErrMsgNotPaused, typeURL)
+		}
+		m.Keeper.DeletePausedMsg(ctx, typeURL)
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeUnpauseMsg,
+			sdk.NewAttribute(types.AttributeKeyTypeURL, typeURL),
+			sdk.NewAttribute(types.AttributeKeyUnpausedBy, msg.UnpausedBy),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgUnpauseMsgsResponse{}, nil
+}
+
+func (m msgServer) BlockAccount(goCtx context.Context, msg *types.MsgBlockAccount) (*types.MsgBlockAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	blockedBy, err := sdk.AccAddressFromBech32(msg.BlockedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.AuthorizedFor(ctx, blockedBy, types.ScopeBlocklist) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.BlockedBy)
+	}
+	// supers have to be deleted before they can be blocked, so that the guardian set is never locked out
+	if _, found := m.Keeper.GetSuper(ctx, address); found {
+		return nil, sdkerrors.Wrap(types.ErrBlockSuper, msg.Address)
+	}
+	if m.Keeper.IsBlocked(ctx, address) {
+		return nil, sdkerrors.Wrap(types.ErrAccountBlocked, msg.Address)
+	}
+
+	m.Keeper.SetBlockedAccount(ctx, types.NewBlockedAccount(address, msg.Reason, blockedBy))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.BlockedBy),
+		),
+		sdk.NewEvent(
+			types.EventTypeBlockAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyBlockedBy, msg.BlockedBy),
+		),
+	})
+
+	return &types.MsgBlockAccountResponse{}, nil
+}
+
+func (m msgServer) UnblockAccount(goCtx context.Context, msg *types.MsgUnblockAccount) (*types.MsgUnblockAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unblockedBy, err := sdk.AccAddressFromBech32(msg.UnblockedBy)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !m.Keeper.AuthorizedFor(ctx, unblockedBy, types.ScopeBlocklist) {
+		return nil, sdkerrors.Wrap(types.ErrUnknownOperator, msg.UnblockedBy)
+	}
+	if !m.Keeper.IsBlocked(ctx, address) {
+		return nil, sdkerrors.Wrap(types.ErrAccountNotBlocked, msg.Address)
+	}
+
+	m.Keeper.DeleteBlockedAccount(ctx, address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.UnblockedBy),
+		),
+		sdk.NewEvent(
+			types.EventTypeUnblockAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyUnblockedBy, msg.UnblockedBy),
+		),
+	})
+
+	return &types.MsgUnblockAccountResponse{}, nil
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// SetMembershipProposal stores the given membership proposal
+func (k Keeper) SetMembershipProposal(ctx sdk.Context, proposal types.MembershipProposal) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&proposal)
+	store.Set(types.GetMembershipProposalKey(proposal.Id), bz)
+}
+
+// GetMembershipProposal retrieves the membership proposal by the specified id
+func (k Keeper) GetMembershipProposal(ctx sdk.Context, id uint64) (proposal types.MembershipProposal, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetMembershipProposalKey(id)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+		return proposal, true
+	}
+	return proposal, false
+}
+
+// DeleteMembershipProposal deletes the stored membership proposal
+func (k Keeper) DeleteMembershipProposal(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetMembershipProposalKey(id))
+}
+
+// IterateMembershipProposals iterates through all pending membership proposals
+func (k Keeper) IterateMembershipProposals(
+	ctx sdk.Context,
+	op func(proposal types.MembershipProposal) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetMembershipProposalsSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.MembershipProposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+
+		if stop := op(proposal); stop {
+			break
+		}
+	}
+}
+
+// GetNextProposalID returns the id of the next membership proposal
+func (k Keeper) GetNextProposalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	var id gogotypes.UInt64Value
+	k.cdc.MustUnmarshalBinaryBare(bz, &id)
+	return id.Value
+}
+
+// SetNextProposalID sets the id of the next membership proposal
+func (k Keeper) SetNextProposalID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.UInt64Value{Value: id})
+	store.Set(types.NextProposalIDKey, bz)
+}
+
+// CountApprovals returns the number of approvals of the given membership proposal
+// which were given by addresses that are still genesis supers
+func (k Keeper) CountApprovals(ctx sdk.Context, proposal types.MembershipProposal) uint32 {
+	var count uint32
+	for _, approver := range proposal.Approvals {
+		address, err := sdk.AccAddressFromBech32(approver)
+		if err != nil {
+			continue
+		}
+		if super, found := k.GetActiveSuper(ctx, address); found && super.AccountType == types.Genesis {
+			count++
+		}
+	}
+	return count
+}
+
+// GetApprovalThreshold returns the number of genesis supers required to apply a membership change.
+// The threshold of the params is capped at the number of genesis supers, so that the guardian set
+// can still be changed after genesis supers have been deleted or the threshold has been raised
+func (k Keeper) GetApprovalThreshold(ctx sdk.Context) uint32 {
+	threshold := k.GetParamSet(ctx).ApprovalThreshold
+	if count := uint32(k.countGenesisSupers(ctx)); count > 0 && count < threshold {
+		return count
+	}
+	return threshold
+}
+
+// ValidateMembershipChange checks whether the given membership change can be applied
+func (k Keeper) ValidateMembershipChange(ctx sdk.Context, action types.MembershipAction, address sdk.AccAddress) error {
+	super, found := k.GetSuper(ctx, address)
+
+	switch action {
+	case types.ActionAddSuper:
+		if found {
+			return sdkerrors.Wrap(types.ErrSuperExists, address.String())
+		}
+		if k.IsBlocked(ctx, address) {
+			return sdkerrors.Wrap(types.ErrAccountBlocked, address.String())
+		}
+		if err := k.ValidateSuperCapacity(ctx); err != nil {
+			return err
+		}
+	case types.ActionDeleteSuper:
+		if !found {
+			return sdkerrors.Wrap(types.ErrUnknownSuper, address.String())
+		}
+		if super.GetAccountType() == types.Genesis {
+			return sdkerrors.Wrap(types.ErrDeleteGenesisSuper, address.String())
+		}
+	default:
+		return sdkerrors.Wrap(types.ErrInvalidAction, action.String())
+	}
+	return nil
+}
+
+// getActiveMembershipProposal retrieves the membership proposal which has not expired yet
+func (k Keeper) getActiveMembershipProposal(ctx sdk.Context, id uint64) (types.MembershipProposal, error) {
+	proposal, found := k.GetMembershipProposal(ctx, id)
+	if !found {
+		return proposal, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", id)
+	}
+	if !ctx.BlockTime().Before(proposal.ExpireTime) {
+		return proposal, sdkerrors.Wrapf(types.ErrProposalExpired, "%d", id)
+	}
+	return proposal, nil
+}
+
+// PruneExpiredMembershipProposals deletes all membership proposals expired at the block time
+func (k Keeper) PruneExpiredMembershipProposals(ctx sdk.Context) {
+	blockTime := ctx.BlockTime()
+
+	var expired []types.MembershipProposal
+	k.IterateMembershipProposals(
+		ctx,
+		func(proposal types.MembershipProposal) bool {
+			if !blockTime.Before(proposal.ExpireTime) {
+				expired = append(expired, proposal)
+			}
+			return false
+		},
+	)
+
+	for _, proposal := range expired {
+		k.DeleteMembershipProposal(ctx, proposal.Id)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireMembershipProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyAction, proposal.Action.String()),
+				sdk.NewAttribute(types.AttributeKeySuperAddress, proposal.Address),
+			),
+		)
+	}
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// HandleAddSuperProposal is a handler for executing a passed add super proposal.
+// Supers added by governance are recorded as added by the gov module account.
+func HandleAddSuperProposal(ctx sdk.Context, k Keeper, p *types.AddSuperProposal) error {
+	address, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return err
+	}
+	if _, found := k.GetSuper(ctx, address); found {
+		return sdkerrors.Wrap(types.ErrSuperExists, p.Address)
+	}
+	if k.IsBlocked(ctx, address) {
+		return sdkerrors.Wrap(types.ErrAccountBlocked, p.Address)
+	}
+	if err := k.ValidateSuperCapacity(ctx); err != nil {
+		return err
+	}
+	if err := k.ValidateDescription(ctx, p.SuperDescription); err != nil {
+		return err
+	}
+
+	addedBy := authtypes.NewModuleAddress(govtypes.ModuleName)
+	super := types.NewSuper(p.SuperDescription, p.AccountType, address, addedBy)
+	k.AddSuper(ctx, super)
+	k.AppendHistory(ctx, types.ActionAddSuper, super, addedBy.String())
+	k.AfterSuperAdded(ctx, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, p.Address),
+			sdk.NewAttribute(types.AttributeKeyAddedBy, addedBy.String()),
+		),
+	)
+	return nil
+}
+
+// HandleDeleteSuperProposal is a handler for executing a passed delete super proposal.
+// Unlike MsgDeleteSuper, a delete super proposal is able to remove genesis supers,
+// except for the last one.
+func HandleDeleteSuperProposal(ctx sdk.Context, k Keeper, p *types.DeleteSuperProposal) error {
+	address, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return err
+	}
+	super, found := k.GetSuper(ctx, address)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownSuper, p.Address)
+	}
+	if super.AccountType == types.Genesis && k.countGenesisSupers(ctx) == 1 {
+		return sdkerrors.Wrapf(types.ErrDeleteGenesisSuper, "%s is the last genesis super", p.Address)
+	}
+
+	deletedBy := authtypes.NewModuleAddress(govtypes.ModuleName)
+	k.DeleteSuper(ctx, address)
+	k.AppendHistory(ctx, types.ActionDeleteSuper, super, deletedBy.String())
+	k.AfterSuperRemoved(ctx, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteSuper,
+			sdk.NewAttribute(types.AttributeKeySuperAddress, p.Address),
+			sdk.NewAttribute(types.AttributeKeyDeletedBy, deletedBy.String()),
+		),
+	)
+	return nil
+}
+
+// countGenesisSupers returns the number of genesis supers
+func (k Keeper) countGenesisSupers(ctx sdk.Context) int {
+	count := 0
+	k.IterateSupers(
+		ctx,
+		func(super types.Super) bool {
+			if super.AccountType == types.Genesis {
+				count++
+			}
+			return false
+		},
+	)
+	return count
+}
@@ -12,20 +12,240 @@ import (
 
 // NewQuerier creates a querier for guardian REST endpoints
 func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
+		case types.QuerySuper:
+			return querySuper(ctx, req, k, legacyQuerierCdc)
 		case types.QuerySupers:
-			return querySupers(ctx, k, legacyQuerierCdc)
+			return querySupers(ctx, req, k, legacyQuerierCdc)
+		case types.QuerySuperDescendants:
+			return querySuperDescendants(ctx, req, k, legacyQuerierCdc)
+		case types.QueryTrustee:
+			return queryTrustee(ctx, req, k, legacyQuerierCdc)
+		case types.QueryTrustees:
+			return queryTrustees(ctx, k, legacyQuerierCdc)
+		case types.QueryHistory:
+			return queryHistory(ctx, req, k, legacyQuerierCdc)
+		case types.QueryExpiringSupers:
+			return queryExpiringSupers(ctx, req, k, legacyQuerierCdc)
+		case types.QueryMembershipProposals:
+			return queryMembershipProposals(ctx, k, legacyQuerierCdc)
+		case types.QueryMembershipProposal:
+			return queryMembershipProposal(ctx, req, k, legacyQuerierCdc)
+		case types.QueryParams:
+			return queryParams(ctx, k, legacyQuerierCdc)
+		case types.QueryPausedMsgs:
+			return queryPausedMsgs(ctx, k, legacyQuerierCdc)
+		case types.QueryBlockedAccount:
+			return queryBlockedAccount(ctx, req, k, legacyQuerierCdc)
+		case types.QueryBlockedAccounts:
+			return queryBlockedAccounts(ctx, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
 	}
 }
 
-func querySupers(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+func querySuper(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QuerySuperParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	super, found := k.GetSuper(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, params.Address.String())
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, super)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func querySuperDescendants(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QuerySuperParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, found := k.GetSuper(ctx, params.Address); !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownSuper, params.Address.String())
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.GetSuperDescendants(ctx, params.Address))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func querySupers(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QuerySupersParams
+	if len(req.Data) > 0 {
+		if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	var addedBy string
+	if !params.AddedBy.Empty() {
+		addedBy = params.AddedBy.String()
+	}
+	filter, err := newSuperFilter(params.AccountType, addedBy)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	var supers []types.Super
 	k.IterateSupers(
 		ctx,
+		func(super types.Super) bool {
+			if filter(super) {
+				supers = append(supers, super)
+			}
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, supers)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// newSuperFilter returns a predicate matching the supers of the given account type
+// and added by the given address; empty criteria match any super
+func newSuperFilter(accountType, addedBy string) (func(types.Super) bool, error) {
+	var typeFilter *types.AccountType
+	if len(accountType) > 0 {
+		t, err := types.AccountTypeFromString(accountType)
+		if err != nil {
+			return nil, err
+		}
+		typeFilter = &t
+	}
+	if len(addedBy) > 0 {
+		if _, err := sdk.AccAddressFromBech32(addedBy); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(super types.Super) bool {
+		if typeFilter != nil && super.AccountType != *typeFilter {
+			return false
+		}
+		return len(addedBy) == 0 || super.AddedBy == addedBy
+	}, nil
+}
+
+func queryTrustee(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryTrusteeParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	trustee, found := k.GetTrustee(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrUnknownTrustee, params.Address.String())
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, trustee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryTrustees(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var trustees []types.Trustee
+	k.IterateTrustees(
+		ctx,
+		func(trustee types.Trustee) bool {
+			trustees = append(trustees, trustee)
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, trustees)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryHistoryParams
+	if len(req.Data) > 0 {
+		if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	var address, operator string
+	if !params.Address.Empty() {
+		address = params.Address.String()
+	}
+	if !params.Operator.Empty() {
+		operator = params.Operator.String()
+	}
+	filter, err := newHistoryFilter(address, operator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var history []types.HistoryEntry
+	k.IterateHistory(
+		ctx,
+		func(entry types.HistoryEntry) bool {
+			if filter(entry) {
+				history = append(history, entry)
+			}
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, history)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// newHistoryFilter returns a predicate matching the history entries of the given super, including
+// its rotations from the given address, and made by the given operator; empty criteria match any entry
+func newHistoryFilter(address, operator string) (func(types.HistoryEntry) bool, error) {
+	if len(address) > 0 {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return nil, err
+		}
+	}
+	if len(operator) > 0 {
+		if _, err := sdk.AccAddressFromBech32(operator); err != nil {
+			return nil, err
+		}
+	}
+
+	return func(entry types.HistoryEntry) bool {
+		if len(address) > 0 && entry.Address != address && entry.PreviousAddress != address {
+			return false
+		}
+		return len(operator) == 0 || entry.Operator == operator
+	}, nil
+}
+
+func queryExpiringSupers(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryExpiringSupersParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var supers []types.Super
+	k.IterateExpiringSupers(
+		ctx,
+		ctx.BlockTime().Add(params.Within),
 		func(super types.Super) bool {
 			supers = append(supers, super)
 			return false
@@ -38,3 +258,98 @@ func querySupers(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino)
 	}
 	return bz, nil
 }
+
+func queryMembershipProposals(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var proposals []types.MembershipProposal
+	k.IterateMembershipProposals(
+		ctx,
+		func(proposal types.MembershipProposal) bool {
+			proposals = append(proposals, proposal)
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, proposals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryMembershipProposal(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryMembershipProposalParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposal, found := k.GetMembershipProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, proposal)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, k.GetParamSet(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPausedMsgs(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var pausedMsgs []types.PausedMsg
+	k.IteratePausedMsgs(
+		ctx,
+		func(pausedMsg types.PausedMsg) bool {
+			pausedMsgs = append(pausedMsgs, pausedMsg)
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, pausedMsgs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryBlockedAccount(ctx sdk.Context, req abci.RequestQuery, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryBlockedAccountParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	account, found := k.GetBlockedAccount(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrAccountNotBlocked, params.Address.String())
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryBlockedAccounts(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var accounts []types.BlockedAccount
+	k.IterateBlockedAccounts(
+		ctx,
+		func(account types.BlockedAccount) bool {
+			accounts = append(accounts, account)
+			return false
+		},
+	)
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, accounts)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
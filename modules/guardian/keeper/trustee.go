@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// AddTrustee stores the given trustee
+func (k Keeper) AddTrustee(ctx sdk.Context, trustee types.Trustee) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&trustee)
+	address, _ := sdk.AccAddressFromBech32(trustee.Address)
+	store.Set(types.GetTrusteeKey(address), bz)
+}
+
+// DeleteTrustee deletes the stored trustee
+func (k Keeper) DeleteTrustee(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTrusteeKey(address))
+}
+
+// GetTrustee retrieves the trustee by specified address
+func (k Keeper) GetTrustee(ctx sdk.Context, addr sdk.AccAddress) (trustee types.Trustee, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetTrusteeKey(addr)); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &trustee)
+		return trustee, true
+	}
+	return trustee, false
+}
+
+// IterateTrustees iterates through all trustees
+func (k Keeper) IterateTrustees(
+	ctx sdk.Context,
+	op func(trustee types.Trustee) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTrusteesSubspaceKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var trustee types.Trustee
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &trustee)
+
+		if stop := op(trustee); stop {
+			break
+		}
+	}
+}
+
+// SpendCommunityPool sends the given amount from the community pool to the recipient, which must
+// neither be a blocked module account nor on the guardian blocklist
+func (k Keeper) SpendCommunityPool(ctx sdk.Context, amount sdk.Coins, recipient sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+	if k.IsBlocked(ctx, recipient) {
+		return sdkerrors.Wrapf(types.ErrAccountBlocked, "recipient %s", recipient)
+	}
+	return k.distrKeeper.DistributeFromFeePool(ctx, amount, recipient)
+}
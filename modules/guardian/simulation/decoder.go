@@ -0,0 +1,63 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// NewDecodeStore returns a function closure that unmarshals the KVPair's values
+// to the corresponding types.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.SuperKey):
+			var superA, superB types.Super
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &superA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &superB)
+			return fmt.Sprintf("%v\n%v", superA, superB)
+		case bytes.Equal(kvA.Key[:1], types.MembershipProposalKey):
+			var proposalA, proposalB types.MembershipProposal
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &proposalA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &proposalB)
+			return fmt.Sprintf("%v\n%v", proposalA, proposalB)
+		case bytes.Equal(kvA.Key[:1], types.NextProposalIDKey),
+			bytes.Equal(kvA.Key[:1], types.NextHistoryIDKey):
+			var idA, idB gogotypes.UInt64Value
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &idA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &idB)
+			return fmt.Sprintf("%v\n%v", idA.Value, idB.Value)
+		case bytes.Equal(kvA.Key[:1], types.ExpiringSuperKey):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.HistoryKey):
+			var entryA, entryB types.HistoryEntry
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &entryA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+		case bytes.Equal(kvA.Key[:1], types.TrusteeKey):
+			var trusteeA, trusteeB types.Trustee
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &trusteeA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &trusteeB)
+			return fmt.Sprintf("%v\n%v", trusteeA, trusteeB)
+		case bytes.Equal(kvA.Key[:1], types.PausedMsgKey):
+			var pausedMsgA, pausedMsgB types.PausedMsg
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &pausedMsgA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &pausedMsgB)
+			return fmt.Sprintf("%v\n%v", pausedMsgA, pausedMsgB)
+		case bytes.Equal(kvA.Key[:1], types.BlockedAccountKey):
+			var accountA, accountB types.BlockedAccount
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &accountA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &accountB)
+			return fmt.Sprintf("%v\n%v", accountA, accountB)
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/irisnet/irishub/modules/guardian/simulation"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+var (
+	addr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc, _ := simapp.MakeCodecs()
+	dec := simulation.NewDecodeStore(cdc)
+
+	super := types.NewSuper("super", types.Genesis, addr, addr)
+	proposal := types.MembershipProposal{Id: 1, Action: types.ActionAddSuper, Address: addr.String(), Proposer: addr.String()}
+	entry := types.HistoryEntry{Id: 1, Action: types.ActionAddSuper, Address: addr.String(), Time: time.Now().UTC()}
+	trustee := types.NewTrustee("trustee", types.Ordinary, addr, addr)
+	pausedMsg := types.NewPausedMsg("/cosmos.bank.v1beta1.MsgSend", 10, addr.String())
+	blockedAccount := types.NewBlockedAccount(addr, "compromised", addr)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetSuperKey(addr), Value: cdc.MustMarshalBinaryBare(&super)},
+			{Key: types.GetMembershipProposalKey(1), Value: cdc.MustMarshalBinaryBare(&proposal)},
+			{Key: types.GetHistoryKey(1), Value: cdc.MustMarshalBinaryBare(&entry)},
+			{Key: types.GetTrusteeKey(addr), Value: cdc.MustMarshalBinaryBare(&trustee)},
+			{Key: types.GetPausedMsgKey(pausedMsg.TypeUrl), Value: cdc.MustMarshalBinaryBare(&pausedMsg)},
+			{Key: types.GetBlockedAccountKey(addr), Value: cdc.MustMarshalBinaryBare(&blockedAccount)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Super", fmt.Sprintf("%v\n%v", super, super)},
+		{"MembershipProposal", fmt.Sprintf("%v\n%v", proposal, proposal)},
+		{"HistoryEntry", fmt.Sprintf("%v\n%v", entry, entry)},
+		{"Trustee", fmt.Sprintf("%v\n%v", trustee, trustee)},
+		{"PausedMsg", fmt.Sprintf("%v\n%v", pausedMsg, pausedMsg)},
+		{"BlockedAccount", fmt.Sprintf("%v\n%v", blockedAccount, blockedAccount)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
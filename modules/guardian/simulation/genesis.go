@@ -0,0 +1,63 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Simulation parameter constants
+const (
+	GenesisSupers  = "genesis_supers"
+	OrdinarySupers = "ordinary_supers"
+)
+
+// RandomizedGenState generates a random GenesisState for guardian
+func RandomizedGenState(simState *module.SimulationState) {
+	var numGenesis, numOrdinary int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GenesisSupers, &numGenesis, simState.Rand,
+		func(r *rand.Rand) { numGenesis = simtypes.RandIntBetween(r, 1, 4) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OrdinarySupers, &numOrdinary, simState.Rand,
+		func(r *rand.Rand) { numOrdinary = r.Intn(5) },
+	)
+
+	accs := simState.Accounts
+	if numGenesis > len(accs) {
+		numGenesis = len(accs)
+	}
+	if numGenesis+numOrdinary > len(accs) {
+		numOrdinary = len(accs) - numGenesis
+	}
+
+	var supers []types.Super
+	for i := 0; i < numGenesis; i++ {
+		supers = append(supers, types.NewSuper(
+			simtypes.RandStringOfLength(simState.Rand, 10), types.Genesis, accs[i].Address, accs[i].Address,
+		))
+	}
+	for i := numGenesis; i < numGenesis+numOrdinary; i++ {
+		addedBy := accs[simState.Rand.Intn(numGenesis)].Address
+		supers = append(supers, types.NewSuper(
+			simtypes.RandStringOfLength(simState.Rand, 10), types.Ordinary, accs[i].Address, addedBy,
+		))
+	}
+
+	guardianGenesis := types.NewGenesisState(supers, types.DefaultParams(), nil, 1, nil, nil, nil, nil)
+
+	bz, err := json.MarshalIndent(&guardianGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(guardianGenesis)
+}
@@ -0,0 +1,186 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/keeper"
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAddSuper    = "op_weight_msg_add_super"
+	OpWeightMsgDeleteSuper = "op_weight_msg_delete_super"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONMarshaler,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.WeightedOperations {
+
+	var weightAdd, weightDelete int
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgAddSuper, &weightAdd, nil,
+		func(_ *rand.Rand) {
+			weightAdd = 50
+		},
+	)
+
+	appParams.GetOrGenerate(
+		cdc, OpWeightMsgDeleteSuper, &weightDelete, nil,
+		func(_ *rand.Rand) {
+			weightDelete = 30
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightAdd,
+			SimulateMsgAddSuper(k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightDelete,
+			SimulateMsgDeleteSuper(k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgAddSuper tests and runs a single msg adding a super. An ordinary super
+// is randomly chosen as the operator from time to time, in which case the msg must fail
+// unless the params allow ordinary supers to add supers
+func SimulateMsgAddSuper(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		params := k.GetParamSet(ctx)
+		if k.GetApprovalThreshold(ctx) > 1 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSuper, "approval required"), nil, nil
+		}
+		if err := k.ValidateSuperCapacity(ctx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSuper, "maximum number of supers reached"), nil, nil
+		}
+
+		genesisSupers, ordinarySupers := randomSupers(ctx, k, accs)
+
+		operators, expectFail := genesisSupers, false
+		if len(ordinarySupers) > 0 && r.Intn(5) == 0 {
+			operators, expectFail = ordinarySupers, !params.OrdinaryCanAddSupers
+		}
+		if len(operators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSuper, "no operator available"), nil, nil
+		}
+		operator := operators[r.Intn(len(operators))]
+
+		newAcc, _ := simtypes.RandomAcc(r, accs)
+		if _, found := k.GetSuper(ctx, newAcc.Address); found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgAddSuper, "account is already a super"), nil, nil
+		}
+
+		description := simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, int(params.MaxDescriptionLength)+1))
+		msg := types.NewMsgAddSuper(description, newAcc.Address, operator.Address)
+		return deliverTx(r, app, ctx, ak, bk, chainID, operator, msg, expectFail)
+	}
+}
+
+// SimulateMsgDeleteSuper tests and runs a single msg deleting a super. A genesis super
+// is randomly chosen as the target from time to time, in which case the msg must fail.
+// The descendants of the target are removed as well from time to time
+func SimulateMsgDeleteSuper(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if k.GetApprovalThreshold(ctx) > 1 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteSuper, "approval required"), nil, nil
+		}
+
+		genesisSupers, ordinarySupers := randomSupers(ctx, k, accs)
+		if len(genesisSupers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgDeleteSuper, "no operator available"), nil, nil
+		}
+		operator := genesisSupers[r.Intn(len(genesisSupers))]
+
+		targets, expectFail := ordinarySupers, false
+		if len(ordinarySupers) == 0 || r.Intn(5) == 0 {
+			targets, expectFail = genesisSupers, true
+		}
+		target := targets[r.Intn(len(targets))]
+
+		msg := types.NewMsgDeleteSuper(target.Address, operator.Address, r.Intn(2) == 0)
+		return deliverTx(r, app, ctx, ak, bk, chainID, operator, msg, expectFail)
+	}
+}
+
+// randomSupers returns the simulation accounts which are genesis and ordinary supers respectively
+func randomSupers(ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (genesisSupers, ordinarySupers []simtypes.Account) {
+	for _, acc := range accs {
+		super, found := k.GetSuper(ctx, acc.Address)
+		if !found || super.IsExpired(ctx.BlockTime()) {
+			continue
+		}
+		if super.AccountType == types.Genesis {
+			genesisSupers = append(genesisSupers, acc)
+		} else {
+			ordinarySupers = append(ordinarySupers, acc)
+		}
+	}
+	return genesisSupers, ordinarySupers
+}
+
+// deliverTx signs the msg with the given account and delivers it, checking
+// the result against whether the msg is expected to fail
+func deliverTx(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	ak types.AccountKeeper, bk types.BankKeeper, chainID string,
+	simAccount simtypes.Account, msg sdk.Msg, expectFail bool,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+	fees, err := simtypes.RandomFees(r, ctx, spendable)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate fees"), nil, err
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txGen.TxEncoder(), tx)
+	switch {
+	case expectFail && err == nil:
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "expected failure"), nil,
+			fmt.Errorf("%s delivered successfully but was expected to fail", msg.Type())
+	case expectFail:
+		return simtypes.NewOperationMsg(msg, false, err.Error()), nil, nil
+	case err != nil:
+		return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
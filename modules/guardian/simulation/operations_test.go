@@ -0,0 +1,114 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/simulation"
+	"github.com/irisnet/irishub/modules/guardian/types"
+	"github.com/irisnet/irishub/simapp"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+	app *simapp.SimApp
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	cdc := suite.app.AppCodec()
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(
+		appParams, cdc, suite.app.GuardianKeeper, suite.app.AccountKeeper, suite.app.BankKeeper,
+	)
+
+	r := rand.New(rand.NewSource(1))
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight  int
+		msgType string
+	}{
+		{50, types.TypeMsgAddSuper},
+		{30, types.TypeMsgDeleteSuper},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, _ := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		suite.Require().Equal(expected[i].weight, w.Weight())
+		suite.Require().Equal(expected[i].msgType, operationMsg.Name)
+	}
+}
+
+func (suite *SimTestSuite) TestSimulateMsgAddSuper() {
+	r := rand.New(rand.NewSource(1))
+	accs := suite.getTestingAccounts(r, 2)
+	suite.app.GuardianKeeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, accs[0].Address, accs[0].Address))
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	op := simulation.SimulateMsgAddSuper(suite.app.GuardianKeeper, suite.app.AccountKeeper, suite.app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accs, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgAddSuper, operationMsg.Name)
+	suite.Require().Len(futureOperations, 0)
+
+	_, found := suite.app.GuardianKeeper.GetSuper(suite.ctx, accs[1].Address)
+	suite.Require().True(found)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgDeleteSuper() {
+	r := rand.New(rand.NewSource(1))
+	accs := suite.getTestingAccounts(r, 2)
+	suite.app.GuardianKeeper.AddSuper(suite.ctx, types.NewSuper("genesis", types.Genesis, accs[0].Address, accs[0].Address))
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	// deleting a genesis super is expected to fail
+	op := simulation.SimulateMsgDeleteSuper(suite.app.GuardianKeeper, suite.app.AccountKeeper, suite.app.BankKeeper)
+	operationMsg, _, err := op(r, suite.app.BaseApp, suite.ctx, accs, "")
+	suite.Require().NoError(err)
+	suite.Require().False(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgDeleteSuper, operationMsg.Name)
+
+	_, found := suite.app.GuardianKeeper.GetSuper(suite.ctx, accs[0].Address)
+	suite.Require().True(found)
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := sdk.TokensFromConsensusPower(200)
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, account.Address, initCoins))
+	}
+
+	return accounts
+}
@@ -0,0 +1,53 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/irisnet/irishub/modules/guardian/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxSupers),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxSupers(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyMaxDescriptionLength),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenMaxDescriptionLength(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyOrdinaryCanAddSupers),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%t", GenOrdinaryCanAddSupers(r))
+			},
+		),
+	}
+}
+
+// GenMaxSupers randomized MaxSupers, half of the time unlimited
+func GenMaxSupers(r *rand.Rand) uint32 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return uint32(simtypes.RandIntBetween(r, 5, 20))
+}
+
+// GenMaxDescriptionLength randomized MaxDescriptionLength
+func GenMaxDescriptionLength(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 10, types.MaxDescriptionLength+1))
+}
+
+// GenOrdinaryCanAddSupers randomized OrdinaryCanAddSupers
+func GenOrdinaryCanAddSupers(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// NewBlockedAccount constructs a BlockedAccount
+func NewBlockedAccount(address sdk.AccAddress, reason string, blockedBy sdk.AccAddress) BlockedAccount {
+	return BlockedAccount{
+		Address:   address.String(),
+		Reason:    reason,
+		BlockedBy: blockedBy.String(),
+	}
+}
+
+// AccAddressFromAnyBech32 decodes a bech32 address regardless of its human readable part, so that
+// the recipients on counterparty chains can be matched against the blocklist
+func AccAddressFromAnyBech32(address string) (sdk.AccAddress, error) {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// unpausableTypeURLPrefixes are the type url prefixes of the guardian and gov messages, which can
+// not be paused so that the circuit breaker is never able to lock out the supers or governance
+var unpausableTypeURLPrefixes = []string{"/irishub.guardian.", "/cosmos.gov."}
+
+// NewPausedMsg constructs a PausedMsg
+func NewPausedMsg(typeURL string, expiryHeight int64, pausedBy string) PausedMsg {
+	return PausedMsg{
+		TypeUrl:      typeURL,
+		ExpiryHeight: expiryHeight,
+		PausedBy:     pausedBy,
+	}
+}
+
+// IsExpired returns true if the message type is enabled again at the given height
+func (p PausedMsg) IsExpired(height int64) bool {
+	return p.ExpiryHeight > 0 && height >= p.ExpiryHeight
+}
+
+// ValidatePausableTypeURL checks if the given message type url can be paused
+func ValidatePausableTypeURL(typeURL string) error {
+	if !strings.HasPrefix(typeURL, "/") || len(strings.TrimSpace(typeURL)) != len(typeURL) || len(typeURL) < 2 {
+		return sdkerrors.Wrapf(ErrInvalidTypeURL, "type url must start with '/' and contain no spaces: %q", typeURL)
+	}
+	for _, prefix := range unpausableTypeURLPrefixes {
+		if strings.HasPrefix(typeURL, prefix) {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "%s messages can not be paused: %s", prefix, typeURL)
+		}
+	}
+	return nil
+}
+
+// ValidatePausableTypeURLs checks if the given message type urls are unique and can be paused
+func ValidatePausableTypeURLs(typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTypeURL, "type urls missing")
+	}
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if seen[typeURL] {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "duplicate type url: %s", typeURL)
+		}
+		seen[typeURL] = true
+
+		if err := ValidatePausableTypeURL(typeURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary module/guardian interfaces and concrete types
@@ -13,12 +14,46 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddSuper{}, "irishub/guardian/MsgAddSuper", nil)
 	cdc.RegisterConcrete(&MsgDeleteSuper{}, "irishub/guardian/MsgDeleteSuper", nil)
+	cdc.RegisterConcrete(&MsgGrantScope{}, "irishub/guardian/MsgGrantScope", nil)
+	cdc.RegisterConcrete(&MsgRevokeScope{}, "irishub/guardian/MsgRevokeScope", nil)
+	cdc.RegisterConcrete(&MsgSubmitMembershipProposal{}, "irishub/guardian/MsgSubmitMembershipProposal", nil)
+	cdc.RegisterConcrete(&MsgApproveMembershipProposal{}, "irishub/guardian/MsgApproveMembershipProposal", nil)
+	cdc.RegisterConcrete(&MsgExecuteMembershipProposal{}, "irishub/guardian/MsgExecuteMembershipProposal", nil)
+	cdc.RegisterConcrete(&MsgAddTrustee{}, "irishub/guardian/MsgAddTrustee", nil)
+	cdc.RegisterConcrete(&MsgDeleteTrustee{}, "irishub/guardian/MsgDeleteTrustee", nil)
+	cdc.RegisterConcrete(&MsgSpendCommunityPool{}, "irishub/guardian/MsgSpendCommunityPool", nil)
+	cdc.RegisterConcrete(&MsgRotateSuper{}, "irishub/guardian/MsgRotateSuper", nil)
+	cdc.RegisterConcrete(&MsgUpdateSuper{}, "irishub/guardian/MsgUpdateSuper", nil)
+	cdc.RegisterConcrete(&MsgPauseMsgs{}, "irishub/guardian/MsgPauseMsgs", nil)
+	cdc.RegisterConcrete(&MsgUnpauseMsgs{}, "irishub/guardian/MsgUnpauseMsgs", nil)
+	cdc.RegisterConcrete(&MsgBlockAccount{}, "irishub/guardian/MsgBlockAccount", nil)
+	cdc.RegisterConcrete(&MsgUnblockAccount{}, "irishub/guardian/MsgUnblockAccount", nil)
+	cdc.RegisterConcrete(&AddSuperProposal{}, "irishub/guardian/AddSuperProposal", nil)
+	cdc.RegisterConcrete(&DeleteSuperProposal{}, "irishub/guardian/DeleteSuperProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAddSuper{},
 		&MsgDeleteSuper{},
+		&MsgGrantScope{},
+		&MsgRevokeScope{},
+		&MsgSubmitMembershipProposal{},
+		&MsgApproveMembershipProposal{},
+		&MsgExecuteMembershipProposal{},
+		&MsgAddTrustee{},
+		&MsgDeleteTrustee{},
+		&MsgSpendCommunityPool{},
+		&MsgRotateSuper{},
+		&MsgUpdateSuper{},
+		&MsgPauseMsgs{},
+		&MsgUnpauseMsgs{},
+		&MsgBlockAccount{},
+		&MsgUnblockAccount{},
+	)
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddSuperProposal{},
+		&DeleteSuperProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -6,8 +6,31 @@ import (
 
 // guardian module sentinel errors
 var (
-	ErrUnknownOperator    = sdkerrors.Register(ModuleName, 2, "unknown operator")
-	ErrUnknownSuper       = sdkerrors.Register(ModuleName, 3, "unknown super")
-	ErrSuperExists        = sdkerrors.Register(ModuleName, 5, "super already exists")
-	ErrDeleteGenesisSuper = sdkerrors.Register(ModuleName, 7, "can't delete genesis super")
+	ErrUnknownOperator      = sdkerrors.Register(ModuleName, 2, "unknown operator")
+	ErrUnknownSuper         = sdkerrors.Register(ModuleName, 3, "unknown super")
+	ErrSuperExists          = sdkerrors.Register(ModuleName, 5, "super already exists")
+	ErrDeleteGenesisSuper   = sdkerrors.Register(ModuleName, 7, "can't delete genesis super")
+	ErrInvalidScope         = sdkerrors.Register(ModuleName, 8, "invalid scope")
+	ErrScopeExists          = sdkerrors.Register(ModuleName, 9, "scope already granted")
+	ErrUnknownScope         = sdkerrors.Register(ModuleName, 10, "scope not granted")
+	ErrGenesisSuperScope    = sdkerrors.Register(ModuleName, 11, "genesis super holds all scopes")
+	ErrInvalidParams        = sdkerrors.Register(ModuleName, 12, "invalid params")
+	ErrUnknownProposal      = sdkerrors.Register(ModuleName, 13, "unknown membership proposal")
+	ErrProposalExpired      = sdkerrors.Register(ModuleName, 14, "membership proposal expired")
+	ErrAlreadyApproved      = sdkerrors.Register(ModuleName, 15, "membership proposal already approved")
+	ErrNotEnoughApprovals   = sdkerrors.Register(ModuleName, 16, "membership proposal not approved by enough genesis supers")
+	ErrApprovalRequired     = sdkerrors.Register(ModuleName, 17, "membership changes require a membership proposal")
+	ErrInvalidAction        = sdkerrors.Register(ModuleName, 18, "invalid membership action")
+	ErrInvalidAccountType   = sdkerrors.Register(ModuleName, 19, "invalid account type")
+	ErrInvalidExpiration    = sdkerrors.Register(ModuleName, 20, "invalid expiration time")
+	ErrUnknownTrustee       = sdkerrors.Register(ModuleName, 21, "unknown trustee")
+	ErrTrusteeExists        = sdkerrors.Register(ModuleName, 22, "trustee already exists")
+	ErrDeleteGenesisTrustee = sdkerrors.Register(ModuleName, 23, "can't delete genesis trustee")
+	ErrInvalidTypeURL       = sdkerrors.Register(ModuleName, 24, "invalid message type url")
+	ErrMsgPaused            = sdkerrors.Register(ModuleName, 25, "message type paused by the circuit breaker")
+	ErrMsgNotPaused         = sdkerrors.Register(ModuleName, 26, "message type not paused")
+	ErrAccountBlocked       = sdkerrors.Register(ModuleName, 27, "account blocked")
+	ErrAccountNotBlocked    = sdkerrors.Register(ModuleName, 28, "account not blocked")
+	ErrBlockSuper           = sdkerrors.Register(ModuleName, 29, "can't block a super")
+	ErrTooManySupers        = sdkerrors.Register(ModuleName, 30, "maximum number of supers reached")
 )
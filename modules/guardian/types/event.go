@@ -3,12 +3,53 @@ package types
 
 // guardian module event types
 const (
-	EventTypeAddSuper    = "add_super"
-	EventTypeDeleteSuper = "delete_super"
+	EventTypeAddSuper       = "add_super"
+	EventTypeDeleteSuper    = "delete_super"
+	EventTypeGrantScope     = "grant_scope"
+	EventTypeRevokeScope    = "revoke_scope"
+	EventTypeRotateSuper    = "rotate_super"
+	EventTypeUpdateSuper    = "update_super"
+	EventTypePauseMsg       = "pause_msg"
+	EventTypeUnpauseMsg     = "unpause_msg"
+	EventTypeBlockAccount   = "block_account"
+	EventTypeUnblockAccount = "unblock_account"
+
+	EventTypeAddTrustee         = "add_trustee"
+	EventTypeDeleteTrustee      = "delete_trustee"
+	EventTypeSpendCommunityPool = "spend_community_pool"
+
+	EventTypeSubmitMembershipProposal  = "submit_membership_proposal"
+	EventTypeApproveMembershipProposal = "approve_membership_proposal"
+	EventTypeExecuteMembershipProposal = "execute_membership_proposal"
+	EventTypeExpireMembershipProposal  = "expire_membership_proposal"
 
 	AttributeKeySuperAddress = "address"
 	AttributeKeyAddedBy      = "added_by"
 	AttributeKeyDeletedBy    = "deleted_by"
+	AttributeKeyCascadedFrom = "cascaded_from"
+	AttributeKeyExpiresAt    = "expires_at"
+	AttributeKeyScope        = "scope"
+	AttributeKeyGrantedBy    = "granted_by"
+	AttributeKeyRevokedBy    = "revoked_by"
+	AttributeKeyProposalID   = "proposal_id"
+	AttributeKeyAction       = "action"
+	AttributeKeyProposer     = "proposer"
+	AttributeKeyApprover     = "approver"
+	AttributeKeyExecutor     = "executor"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyTrustee      = "trustee"
+	AttributeKeyNewAddress   = "new_address"
+	AttributeKeyDescription  = "description"
+	AttributeKeyUpdatedBy    = "updated_by"
+	AttributeKeyTypeURL      = "type_url"
+	AttributeKeyExpiryHeight = "expiry_height"
+	AttributeKeyPausedBy     = "paused_by"
+	AttributeKeyUnpausedBy   = "unpaused_by"
+	AttributeKeyReason       = "reason"
+	AttributeKeyAccount      = "account"
+	AttributeKeyBlockedBy    = "blocked_by"
+	AttributeKeyUnblockedBy  = "unblocked_by"
 
 	AttributeValueCategory = ModuleName
 )
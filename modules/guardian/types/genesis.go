@@ -1,13 +1,32 @@
 package types
 
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(supers []Super) *GenesisState {
+func NewGenesisState(
+	supers []Super,
+	params Params,
+	proposals []MembershipProposal,
+	nextProposalID uint64,
+	history []HistoryEntry,
+	trustees []Trustee,
+	pausedMsgs []PausedMsg,
+	blockedAccounts []BlockedAccount,
+) *GenesisState {
 	return &GenesisState{
-		Supers: supers,
+		Supers:          supers,
+		Params:          params,
+		Proposals:       proposals,
+		NextProposalId:  nextProposalID,
+		History:         history,
+		Trustees:        trustees,
+		PausedMsgs:      pausedMsgs,
+		BlockedAccounts: blockedAccounts,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params:         DefaultParams(),
+		NextProposalId: 1,
+	}
 }
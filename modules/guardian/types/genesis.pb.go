@@ -25,7 +25,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the guardian module's genesis state.
 type GenesisState struct {
-	Supers []Super `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	Supers          []Super              `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
+	Params          Params               `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Proposals       []MembershipProposal `protobuf:"bytes,3,rep,name=proposals,proto3" json:"proposals"`
+	NextProposalId  uint64               `protobuf:"varint,4,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty" yaml:"next_proposal_id"`
+	History         []HistoryEntry       `protobuf:"bytes,5,rep,name=history,proto3" json:"history"`
+	Trustees        []Trustee            `protobuf:"bytes,6,rep,name=trustees,proto3" json:"trustees"`
+	PausedMsgs      []PausedMsg          `protobuf:"bytes,7,rep,name=paused_msgs,json=pausedMsgs,proto3" json:"paused_msgs" yaml:"paused_msgs"`
+	BlockedAccounts []BlockedAccount     `protobuf:"bytes,8,rep,name=blocked_accounts,json=blockedAccounts,proto3" json:"blocked_accounts" yaml:"blocked_accounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +75,55 @@ func (m *GenesisState) GetSupers() []Super {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetProposals() []MembershipProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextProposalId() uint64 {
+	if m != nil {
+		return m.NextProposalId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHistory() []HistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrustees() []Trustee {
+	if m != nil {
+		return m.Trustees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedMsgs() []PausedMsg {
+	if m != nil {
+		return m.PausedMsgs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedAccounts() []BlockedAccount {
+	if m != nil {
+		return m.BlockedAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irishub.guardian.GenesisState")
 }
@@ -75,20 +131,35 @@ func init() {
 func init() { proto.RegisterFile("guardian/genesis.proto", fileDescriptor_5203106ad1456439) }
 
 var fileDescriptor_5203106ad1456439 = []byte{
-	// 195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0xc8, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0xc9, 0x4b, 0x89,
-	0x23, 0x54, 0x42, 0x19, 0x10, 0xa5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88,
-	0x05, 0x11, 0x55, 0x72, 0xe5, 0xe2, 0x71, 0x87, 0x98, 0x18, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64,
-	0xca, 0xc5, 0x56, 0x5c, 0x5a, 0x90, 0x5a, 0x54, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24,
-	0xae, 0x87, 0x6e, 0x83, 0x5e, 0x30, 0x48, 0xde, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8,
-	0x62, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf,
-	0x2c, 0x01, 0x69, 0x4f, 0xce, 0xcf, 0xd5, 0x07, 0x19, 0x95, 0x97, 0x5a, 0xa2, 0x0f, 0x35, 0x52,
-	0x3f, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x18, 0xee, 0x52, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0xd3, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x50, 0x6e, 0x87, 0x2a, 0xf5,
-	0x00, 0x00, 0x00,
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x1b, 0xb7, 0x76, 0xd7, 0xa9, 0x68, 0x19, 0xc4, 0x8e, 0x5d, 0x48, 0x4b, 0xf0, 0xd0,
+	0x53, 0x83, 0x2b, 0x7a, 0x50, 0x10, 0x0c, 0x2c, 0xae, 0xc8, 0xc2, 0xd2, 0xf5, 0x20, 0x5e, 0xca,
+	0x24, 0x19, 0xd2, 0xc1, 0x24, 0x33, 0xcc, 0x9b, 0x80, 0xf9, 0x16, 0x7e, 0xac, 0x3d, 0x2e, 0x78,
+	0xf1, 0x54, 0xa4, 0xfd, 0x06, 0xfb, 0x09, 0x24, 0x33, 0x93, 0x5d, 0x6d, 0xbc, 0x3d, 0xde, 0xfb,
+	0xfd, 0xff, 0xff, 0x37, 0xc3, 0x43, 0x4f, 0xb3, 0x8a, 0xaa, 0x94, 0xd3, 0x32, 0xcc, 0x58, 0xc9,
+	0x80, 0xc3, 0x42, 0x2a, 0xa1, 0x05, 0x1e, 0x71, 0xc5, 0x61, 0x5d, 0xc5, 0x8b, 0x76, 0x3e, 0x19,
+	0xdf, 0x91, 0xae, 0xb0, 0xe8, 0xe4, 0x49, 0x26, 0x32, 0x61, 0xca, 0xb0, 0xa9, 0x6c, 0x37, 0xf8,
+	0xd9, 0x47, 0x0f, 0x3f, 0x58, 0xcb, 0x4b, 0x4d, 0x35, 0xc3, 0xaf, 0xd0, 0x00, 0x2a, 0xc9, 0x14,
+	0x10, 0x6f, 0x76, 0x30, 0x1f, 0x9e, 0x8c, 0x17, 0xfb, 0x11, 0x8b, 0xcb, 0x66, 0x1e, 0xf5, 0xaf,
+	0x36, 0xd3, 0xde, 0xd2, 0xc1, 0xf8, 0x35, 0x1a, 0x48, 0xaa, 0x68, 0x01, 0xe4, 0xde, 0xcc, 0x9b,
+	0x0f, 0x4f, 0x48, 0x57, 0x76, 0x61, 0xe6, 0xad, 0xce, 0xd2, 0xf8, 0x0c, 0x3d, 0x90, 0x4a, 0x48,
+	0x01, 0x34, 0x07, 0x72, 0x60, 0x12, 0x9f, 0x77, 0xa5, 0xe7, 0xac, 0x88, 0x99, 0x82, 0x35, 0x97,
+	0x17, 0x0e, 0x76, 0x36, 0x77, 0x62, 0x7c, 0x8a, 0x46, 0x25, 0xfb, 0xae, 0x57, 0x6d, 0x67, 0xc5,
+	0x53, 0xd2, 0x9f, 0x79, 0xf3, 0x7e, 0x74, 0x7c, 0xb3, 0x99, 0x8e, 0x6b, 0x5a, 0xe4, 0x6f, 0x82,
+	0x7d, 0x22, 0x58, 0x3e, 0x6a, 0x5a, 0xad, 0xeb, 0xc7, 0x14, 0xbf, 0x43, 0x87, 0x6b, 0x0e, 0x5a,
+	0xa8, 0x9a, 0xdc, 0x37, 0xeb, 0xf8, 0xdd, 0x75, 0xce, 0x2c, 0x70, 0x5a, 0x6a, 0x55, 0xbb, 0x45,
+	0x5a, 0x11, 0x7e, 0x8b, 0x8e, 0xb4, 0xaa, 0x40, 0x33, 0x06, 0x64, 0x60, 0x0c, 0x9e, 0x75, 0x0d,
+	0x3e, 0x5b, 0xc2, 0x69, 0x6f, 0x05, 0xf8, 0x0b, 0x1a, 0x4a, 0x5a, 0x01, 0x4b, 0x57, 0x05, 0x64,
+	0x40, 0x0e, 0x8d, 0xfe, 0xf8, 0x7f, 0x5f, 0xd9, 0x40, 0xe7, 0x90, 0x45, 0x93, 0xc6, 0xe1, 0x66,
+	0x33, 0xc5, 0xf6, 0x7d, 0x7f, 0xa9, 0x83, 0x25, 0x92, 0x2d, 0x06, 0x38, 0x47, 0xa3, 0x38, 0x17,
+	0xc9, 0x37, 0x96, 0xae, 0x68, 0x92, 0x88, 0xaa, 0xd4, 0x40, 0x8e, 0x8c, 0xfd, 0xac, 0x6b, 0x1f,
+	0x59, 0xf2, 0xbd, 0x05, 0xa3, 0xa9, 0xcb, 0x70, 0x7f, 0xb8, 0xef, 0x13, 0x2c, 0x1f, 0xc7, 0xff,
+	0x08, 0x20, 0xfa, 0x74, 0xb5, 0xf5, 0xbd, 0xeb, 0xad, 0xef, 0xfd, 0xde, 0xfa, 0xde, 0x8f, 0x9d,
+	0xdf, 0xbb, 0xde, 0xf9, 0xbd, 0x5f, 0x3b, 0xbf, 0xf7, 0xf5, 0x45, 0xc6, 0x75, 0x93, 0x95, 0x88,
+	0x22, 0x6c, 0x72, 0x4b, 0xa6, 0x43, 0x97, 0x1f, 0x16, 0x22, 0xad, 0x72, 0x06, 0xb7, 0x87, 0x1b,
+	0xea, 0x5a, 0x32, 0x88, 0x07, 0xe6, 0x52, 0x5f, 0xfe, 0x19, 0x00, 0x59, 0xed, 0x20, 0xcd, 0x04,
+	0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +182,91 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedAccounts) > 0 {
+		for iNdEx := len(m.BlockedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PausedMsgs) > 0 {
+		for iNdEx := len(m.PausedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Trustees) > 0 {
+		for iNdEx := len(m.Trustees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trustees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Supers) > 0 {
 		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,6 +307,41 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextProposalId))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Trustees) > 0 {
+		for _, e := range m.Trustees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedMsgs) > 0 {
+		for _, e := range m.PausedMsgs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedAccounts) > 0 {
+		for _, e := range m.BlockedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -223,6 +414,228 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, MembershipProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalId", wireType)
+			}
+			m.NextProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, HistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trustees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trustees = append(m.Trustees, Trustee{})
+			if err := m.Trustees[len(m.Trustees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedMsgs = append(m.PausedMsgs, PausedMsg{})
+			if err := m.PausedMsgs[len(m.PausedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAccounts = append(m.BlockedAccounts, BlockedAccount{})
+			if err := m.BlockedAccounts[len(m.BlockedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
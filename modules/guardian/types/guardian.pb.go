@@ -7,15 +7,20 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -51,12 +56,83 @@ func (AccountType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_07c8fad859e95e75, []int{0}
 }
 
+// Scope defines a permission scope which can be granted to a super
+type Scope int32
+
+const (
+	// SCOPE_UNSPECIFIED defines an invalid scope
+	ScopeUnspecified Scope = 0
+	// SCOPE_ORACLE defines the scope for oracle feed management
+	ScopeOracle Scope = 1
+	// SCOPE_CIRCUIT_BREAKER defines the scope for pausing message types
+	ScopeCircuitBreaker Scope = 4
+	// SCOPE_BLOCKLIST defines the scope for blocking accounts
+	ScopeBlocklist Scope = 5
+)
+
+var Scope_name = map[int32]string{
+	0: "SCOPE_UNSPECIFIED",
+	1: "SCOPE_ORACLE",
+	4: "SCOPE_CIRCUIT_BREAKER",
+	5: "SCOPE_BLOCKLIST",
+}
+
+var Scope_value = map[string]int32{
+	"SCOPE_UNSPECIFIED":     0,
+	"SCOPE_ORACLE":          1,
+	"SCOPE_CIRCUIT_BREAKER": 4,
+	"SCOPE_BLOCKLIST":       5,
+}
+
+func (x Scope) String() string {
+	return proto.EnumName(Scope_name, int32(x))
+}
+
+func (Scope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{1}
+}
+
+// MembershipAction defines the change proposed by a membership proposal
+type MembershipAction int32
+
+const (
+	// ACTION_ADD_SUPER defines the action of adding an ordinary super
+	ActionAddSuper MembershipAction = 0
+	// ACTION_DELETE_SUPER defines the action of deleting an ordinary super
+	ActionDeleteSuper MembershipAction = 1
+	// ACTION_ROTATE_SUPER records the move of a super to a new address, it can not be proposed
+	ActionRotateSuper MembershipAction = 2
+)
+
+var MembershipAction_name = map[int32]string{
+	0: "ACTION_ADD_SUPER",
+	1: "ACTION_DELETE_SUPER",
+	2: "ACTION_ROTATE_SUPER",
+}
+
+var MembershipAction_value = map[string]int32{
+	"ACTION_ADD_SUPER":    0,
+	"ACTION_DELETE_SUPER": 1,
+	"ACTION_ROTATE_SUPER": 2,
+}
+
+func (x MembershipAction) String() string {
+	return proto.EnumName(MembershipAction_name, int32(x))
+}
+
+func (MembershipAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{2}
+}
+
 // Super defines the super standard
 type Super struct {
 	Description string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
 	AccountType AccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=irishub.guardian.AccountType" json:"account_type,omitempty" yaml:"account_type"`
 	Address     string      `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	AddedBy     string      `protobuf:"bytes,4,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	Scopes      []Scope     `protobuf:"varint,5,rep,packed,name=scopes,proto3,enum=irishub.guardian.Scope" json:"scopes,omitempty"`
+	// expires_at is the optional time after which the super is removed
+	ExpiresAt *time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
 }
 
 func (m *Super) Reset()         { *m = Super{} }
@@ -120,129 +196,2958 @@ func (m *Super) GetAddedBy() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("irishub.guardian.AccountType", AccountType_name, AccountType_value)
-	proto.RegisterType((*Super)(nil), "irishub.guardian.Super")
+func (m *Super) GetScopes() []Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("guardian/guardian.proto", fileDescriptor_07c8fad859e95e75) }
+func (m *Super) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
 
-var fileDescriptor_07c8fad859e95e75 = []byte{
-	// 325 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x87, 0x31, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x32,
-	0x8b, 0x32, 0x8b, 0x33, 0x4a, 0x93, 0xf4, 0x60, 0xe2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
-	0x49, 0x7d, 0x10, 0x0b, 0xa2, 0x4e, 0x69, 0x27, 0x23, 0x17, 0x6b, 0x70, 0x69, 0x41, 0x6a, 0x91,
-	0x90, 0x02, 0x17, 0x77, 0x4a, 0x6a, 0x71, 0x72, 0x51, 0x66, 0x41, 0x49, 0x66, 0x7e, 0x9e, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0xb2, 0x90, 0x50, 0x24, 0x17, 0x4f, 0x62, 0x72, 0x72, 0x7e,
-	0x69, 0x5e, 0x49, 0x7c, 0x49, 0x65, 0x41, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x9f, 0x91, 0xac,
-	0x1e, 0xba, 0x55, 0x7a, 0x8e, 0x10, 0x55, 0x21, 0x95, 0x05, 0xa9, 0x4e, 0xe2, 0x9f, 0xee, 0xc9,
-	0x0b, 0x57, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0x21, 0x6b, 0x56, 0x0a, 0xe2, 0x4e, 0x44, 0xa8, 0x12,
-	0x92, 0xe0, 0x62, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x06, 0x5b, 0x0c, 0xe3,
-	0x0a, 0x49, 0x72, 0x71, 0x24, 0xa6, 0xa4, 0xa4, 0xa6, 0xc4, 0x27, 0x55, 0x4a, 0xb0, 0xc0, 0xa5,
-	0x52, 0x53, 0x9c, 0x2a, 0xb5, 0x3c, 0xb9, 0xb8, 0x1d, 0x51, 0xcd, 0x70, 0x77, 0xf5, 0x73, 0x0d,
-	0xf6, 0x0c, 0x16, 0x60, 0x90, 0xe2, 0xee, 0x9a, 0xab, 0xc0, 0xee, 0x9e, 0x9a, 0x97, 0x5a, 0x9c,
-	0x59, 0x2c, 0x24, 0xc5, 0xc5, 0xe1, 0x1f, 0xe4, 0xe2, 0xe9, 0xe7, 0x18, 0x14, 0x29, 0xc0, 0x28,
-	0xc5, 0xd3, 0x35, 0x57, 0x81, 0xc3, 0xbf, 0x28, 0x25, 0x33, 0x2f, 0xb1, 0xa8, 0x52, 0x8a, 0xa5,
-	0x63, 0xb1, 0x1c, 0x83, 0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
-	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
-	0x19, 0xa6, 0x67, 0x96, 0x80, 0x3c, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf2, 0x68, 0x5e, 0x6a, 0x89,
-	0x3e, 0xd4, 0xc3, 0xfa, 0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0xc5, 0xf0, 0xb0, 0xd7, 0x07, 0xf9,
-	0xac, 0x38, 0x89, 0x0d, 0x1c, 0xb4, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x96, 0xfb,
-	0xdc, 0x9d, 0x01, 0x00, 0x00,
+// Trustee defines the trustee standard, a trustee is able to spend from the community pool
+type Trustee struct {
+	Description string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	AccountType AccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=irishub.guardian.AccountType" json:"account_type,omitempty" yaml:"account_type"`
+	Address     string      `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	AddedBy     string      `protobuf:"bytes,4,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
 }
 
-func (m *Super) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Trustee) Reset()         { *m = Trustee{} }
+func (m *Trustee) String() string { return proto.CompactTextString(m) }
+func (*Trustee) ProtoMessage()    {}
+func (*Trustee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{1}
+}
+func (m *Trustee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trustee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trustee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Trustee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trustee.Merge(m, src)
+}
+func (m *Trustee) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trustee) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trustee.DiscardUnknown(m)
 }
 
-func (m *Super) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Trustee proto.InternalMessageInfo
+
+func (m *Trustee) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *Super) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddedBy) > 0 {
-		i -= len(m.AddedBy)
-		copy(dAtA[i:], m.AddedBy)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
-		i--
-		dAtA[i] = 0x22
+func (m *Trustee) GetAccountType() AccountType {
+	if m != nil {
+		return m.AccountType
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+	return Genesis
+}
+
+func (m *Trustee) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.AccountType != 0 {
-		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *Trustee) GetAddedBy() string {
+	if m != nil {
+		return m.AddedBy
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// MembershipProposal defines a pending change of the guardian set, which can be
+// executed once it has been approved by enough genesis supers
+type MembershipProposal struct {
+	Id          uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action      MembershipAction `protobuf:"varint,2,opt,name=action,proto3,enum=irishub.guardian.MembershipAction" json:"action,omitempty"`
+	Address     string           `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Description string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Proposer    string           `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Approvals   []string         `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	ExpireTime  time.Time        `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time" yaml:"expire_time"`
+}
+
+func (m *MembershipProposal) Reset()         { *m = MembershipProposal{} }
+func (m *MembershipProposal) String() string { return proto.CompactTextString(m) }
+func (*MembershipProposal) ProtoMessage()    {}
+func (*MembershipProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{2}
+}
+func (m *MembershipProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MembershipProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MembershipProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MembershipProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembershipProposal.Merge(m, src)
+}
+func (m *MembershipProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MembershipProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembershipProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembershipProposal proto.InternalMessageInfo
+
+func (m *MembershipProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MembershipProposal) GetAction() MembershipAction {
+	if m != nil {
+		return m.Action
+	}
+	return ActionAddSuper
+}
+
+func (m *MembershipProposal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MembershipProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MembershipProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MembershipProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *MembershipProposal) GetExpireTime() time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return time.Time{}
+}
+
+// HistoryEntry defines a record of the guardian membership change log
+type HistoryEntry struct {
+	Id          uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action      MembershipAction `protobuf:"varint,2,opt,name=action,proto3,enum=irishub.guardian.MembershipAction" json:"action,omitempty"`
+	Address     string           `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Description string           `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	AccountType AccountType      `protobuf:"varint,5,opt,name=account_type,json=accountType,proto3,enum=irishub.guardian.AccountType" json:"account_type,omitempty" yaml:"account_type"`
+	// operator is the address which made the change, empty if the super expired
+	Operator string    `protobuf:"bytes,6,opt,name=operator,proto3" json:"operator,omitempty"`
+	Height   int64     `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Time     time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+	// previous address of a rotated super, empty for the other actions
+	PreviousAddress string `protobuf:"bytes,9,opt,name=previous_address,json=previousAddress,proto3" json:"previous_address,omitempty" yaml:"previous_address"`
+}
+
+func (m *HistoryEntry) Reset()         { *m = HistoryEntry{} }
+func (m *HistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*HistoryEntry) ProtoMessage()    {}
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{3}
+}
+func (m *HistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryEntry.Merge(m, src)
+}
+func (m *HistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryEntry proto.InternalMessageInfo
+
+func (m *HistoryEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetAction() MembershipAction {
+	if m != nil {
+		return m.Action
+	}
+	return ActionAddSuper
+}
+
+func (m *HistoryEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetAccountType() AccountType {
+	if m != nil {
+		return m.AccountType
+	}
+	return Genesis
+}
+
+func (m *HistoryEntry) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *HistoryEntry) GetPreviousAddress() string {
+	if m != nil {
+		return m.PreviousAddress
+	}
+	return ""
+}
+
+// guardian parameters
+type Params struct {
+	// number of genesis super approvals required to execute a membership proposal, capped at the number of genesis supers
+	ApprovalThreshold uint32 `protobuf:"varint,1,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty" yaml:"approval_threshold"`
+	// period after which a membership proposal which has not been executed expires
+	ProposalLifetime time.Duration `protobuf:"bytes,2,opt,name=proposal_lifetime,json=proposalLifetime,proto3,stdduration" json:"proposal_lifetime" yaml:"proposal_lifetime"`
+	// maximum number of supers, 0 if unlimited
+	MaxSupers uint32 `protobuf:"varint,3,opt,name=max_supers,json=maxSupers,proto3" json:"max_supers,omitempty" yaml:"max_supers"`
+	// maximum length of the descriptions of supers and trustees
+	MaxDescriptionLength uint32 `protobuf:"varint,4,opt,name=max_description_length,json=maxDescriptionLength,proto3" json:"max_description_length,omitempty" yaml:"max_description_length"`
+	// whether ordinary supers are able to add supers
+	OrdinaryCanAddSupers bool `protobuf:"varint,5,opt,name=ordinary_can_add_supers,json=ordinaryCanAddSupers,proto3" json:"ordinary_can_add_supers,omitempty" yaml:"ordinary_can_add_supers"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{4}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetApprovalThreshold() uint32 {
+	if m != nil {
+		return m.ApprovalThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetProposalLifetime() time.Duration {
+	if m != nil {
+		return m.ProposalLifetime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSupers() uint32 {
+	if m != nil {
+		return m.MaxSupers
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDescriptionLength() uint32 {
+	if m != nil {
+		return m.MaxDescriptionLength
+	}
+	return 0
+}
+
+func (m *Params) GetOrdinaryCanAddSupers() bool {
+	if m != nil {
+		return m.OrdinaryCanAddSupers
+	}
+	return false
+}
+
+// AddSuperProposal defines a gov proposal to add a super
+type AddSuperProposal struct {
+	Title            string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Address          string      `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	SuperDescription string      `protobuf:"bytes,4,opt,name=super_description,json=superDescription,proto3" json:"super_description,omitempty" yaml:"super_description"`
+	AccountType      AccountType `protobuf:"varint,5,opt,name=account_type,json=accountType,proto3,enum=irishub.guardian.AccountType" json:"account_type,omitempty" yaml:"account_type"`
+}
+
+func (m *AddSuperProposal) Reset()      { *m = AddSuperProposal{} }
+func (*AddSuperProposal) ProtoMessage() {}
+func (*AddSuperProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{5}
+}
+func (m *AddSuperProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddSuperProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddSuperProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddSuperProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSuperProposal.Merge(m, src)
+}
+func (m *AddSuperProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddSuperProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSuperProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSuperProposal proto.InternalMessageInfo
+
+// DeleteSuperProposal defines a gov proposal to delete a super
+type DeleteSuperProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Address     string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *DeleteSuperProposal) Reset()      { *m = DeleteSuperProposal{} }
+func (*DeleteSuperProposal) ProtoMessage() {}
+func (*DeleteSuperProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{6}
+}
+func (m *DeleteSuperProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteSuperProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteSuperProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteSuperProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSuperProposal.Merge(m, src)
+}
+func (m *DeleteSuperProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteSuperProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSuperProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSuperProposal proto.InternalMessageInfo
+
+// PausedMsg defines a message type disabled by the circuit breaker
+type PausedMsg struct {
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty" yaml:"type_url"`
+	// expiry_height is the optional height from which the message type is enabled again, 0 if never
+	ExpiryHeight int64  `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	PausedBy     string `protobuf:"bytes,3,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty" yaml:"paused_by"`
+}
+
+func (m *PausedMsg) Reset()         { *m = PausedMsg{} }
+func (m *PausedMsg) String() string { return proto.CompactTextString(m) }
+func (*PausedMsg) ProtoMessage()    {}
+func (*PausedMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{7}
+}
+func (m *PausedMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedMsg.Merge(m, src)
+}
+func (m *PausedMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedMsg proto.InternalMessageInfo
+
+func (m *PausedMsg) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *PausedMsg) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *PausedMsg) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
+// BlockedAccount defines an account which is neither able to sign transactions nor to receive transfers
+type BlockedAccount struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	BlockedBy string `protobuf:"bytes,3,opt,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty" yaml:"blocked_by"`
+}
+
+func (m *BlockedAccount) Reset()         { *m = BlockedAccount{} }
+func (m *BlockedAccount) String() string { return proto.CompactTextString(m) }
+func (*BlockedAccount) ProtoMessage()    {}
+func (*BlockedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07c8fad859e95e75, []int{8}
+}
+func (m *BlockedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAccount.Merge(m, src)
+}
+func (m *BlockedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAccount proto.InternalMessageInfo
+
+func (m *BlockedAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlockedAccount) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BlockedAccount) GetBlockedBy() string {
+	if m != nil {
+		return m.BlockedBy
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("irishub.guardian.AccountType", AccountType_name, AccountType_value)
+	proto.RegisterEnum("irishub.guardian.Scope", Scope_name, Scope_value)
+	proto.RegisterEnum("irishub.guardian.MembershipAction", MembershipAction_name, MembershipAction_value)
+	proto.RegisterType((*Super)(nil), "irishub.guardian.Super")
+	proto.RegisterType((*Trustee)(nil), "irishub.guardian.Trustee")
+	proto.RegisterType((*MembershipProposal)(nil), "irishub.guardian.MembershipProposal")
+	proto.RegisterType((*HistoryEntry)(nil), "irishub.guardian.HistoryEntry")
+	proto.RegisterType((*Params)(nil), "irishub.guardian.Params")
+	proto.RegisterType((*AddSuperProposal)(nil), "irishub.guardian.AddSuperProposal")
+	proto.RegisterType((*DeleteSuperProposal)(nil), "irishub.guardian.DeleteSuperProposal")
+	proto.RegisterType((*PausedMsg)(nil), "irishub.guardian.PausedMsg")
+	proto.RegisterType((*BlockedAccount)(nil), "irishub.guardian.BlockedAccount")
+}
+
+func init() { proto.RegisterFile("guardian/guardian.proto", fileDescriptor_07c8fad859e95e75) }
+
+var fileDescriptor_07c8fad859e95e75 = []byte{
+	// 1300 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x8f, 0x1a, 0xc7,
+	0x12, 0x66, 0x80, 0x65, 0xa1, 0xd9, 0x1f, 0xb3, 0xbd, 0xd8, 0xcb, 0xce, 0xb3, 0x19, 0x3c, 0x7a,
+	0xd2, 0x5b, 0xed, 0x93, 0x40, 0xde, 0xf7, 0x0e, 0xd1, 0x4a, 0x39, 0x30, 0x30, 0xb6, 0xd1, 0xe2,
+	0x65, 0xd5, 0xb0, 0x89, 0x9c, 0x1c, 0x46, 0xbd, 0x4c, 0x1b, 0x46, 0x1e, 0x98, 0x51, 0xf7, 0x60,
+	0x2d, 0xff, 0x81, 0xc5, 0xc9, 0x47, 0x5f, 0x90, 0xac, 0xe4, 0x90, 0x53, 0x4e, 0xc9, 0x2d, 0x97,
+	0x1c, 0x7d, 0xf4, 0x31, 0x27, 0x12, 0xad, 0x2f, 0x39, 0xf3, 0x17, 0x44, 0xd3, 0x3d, 0x03, 0x18,
+	0xe4, 0xf8, 0x92, 0x44, 0xc9, 0x6d, 0xaa, 0xea, 0xab, 0xee, 0xea, 0xaf, 0xeb, 0xab, 0x1e, 0x70,
+	0xd0, 0x1d, 0x62, 0x6a, 0xd9, 0x78, 0x50, 0x8e, 0x3e, 0x4a, 0x1e, 0x75, 0x7d, 0x17, 0xca, 0x36,
+	0xb5, 0x59, 0x6f, 0x78, 0x55, 0x8a, 0xfc, 0x4a, 0xae, 0xeb, 0x76, 0x5d, 0x1e, 0x2c, 0x07, 0x5f,
+	0x02, 0xa7, 0x14, 0xba, 0xae, 0xdb, 0x75, 0x48, 0x99, 0x5b, 0x57, 0xc3, 0xa7, 0x65, 0x6b, 0x48,
+	0xb1, 0x6f, 0xbb, 0xe1, 0x3a, 0x8a, 0xba, 0x1a, 0xf7, 0xed, 0x3e, 0x61, 0x3e, 0xee, 0x7b, 0x02,
+	0xa0, 0xfd, 0x18, 0x07, 0x1b, 0xad, 0xa1, 0x47, 0x28, 0x2c, 0x82, 0xac, 0x45, 0x58, 0x87, 0xda,
+	0x5e, 0x90, 0x9f, 0x97, 0x8a, 0xd2, 0x51, 0x06, 0x2d, 0xbb, 0xe0, 0x13, 0xb0, 0x85, 0x3b, 0x1d,
+	0x77, 0x38, 0xf0, 0x4d, 0x7f, 0xe4, 0x91, 0x7c, 0xbc, 0x28, 0x1d, 0xed, 0x9c, 0xdc, 0x2d, 0xad,
+	0xd6, 0x5a, 0xaa, 0x08, 0x54, 0x7b, 0xe4, 0x11, 0xfd, 0x60, 0x36, 0x55, 0xf7, 0x47, 0xb8, 0xef,
+	0x9c, 0x6a, 0xcb, 0xc9, 0x1a, 0xca, 0xe2, 0x05, 0x0a, 0xe6, 0xc1, 0x26, 0xb6, 0x2c, 0x4a, 0x18,
+	0xcb, 0x27, 0xf8, 0xc6, 0x91, 0x09, 0x0f, 0x41, 0x1a, 0x5b, 0x16, 0xb1, 0xcc, 0xab, 0x51, 0x3e,
+	0x39, 0x0f, 0x11, 0x4b, 0x1f, 0xc1, 0x32, 0x48, 0xb1, 0x8e, 0xeb, 0x11, 0x96, 0xdf, 0x28, 0x26,
+	0x8e, 0x76, 0x4e, 0x0e, 0xd6, 0x2b, 0x69, 0x05, 0x71, 0x14, 0xc2, 0x60, 0x1b, 0x00, 0x72, 0xed,
+	0xd9, 0x94, 0x30, 0x13, 0xfb, 0xf9, 0x54, 0x51, 0x3a, 0xca, 0x9e, 0x28, 0x25, 0x41, 0x51, 0x29,
+	0xa2, 0xa8, 0xd4, 0x8e, 0x28, 0xd2, 0x0f, 0x67, 0x53, 0x75, 0x4f, 0xd4, 0xbe, 0xc8, 0xd3, 0x5e,
+	0xfe, 0xac, 0x4a, 0x28, 0x13, 0x3a, 0x2a, 0xbe, 0xf6, 0x83, 0x04, 0x36, 0xdb, 0x74, 0xc8, 0x7c,
+	0x42, 0xfe, 0x79, 0x24, 0x6a, 0xdf, 0xc5, 0x01, 0x7c, 0x4c, 0xfa, 0x57, 0x84, 0xb2, 0x9e, 0xed,
+	0x5d, 0x50, 0xd7, 0x73, 0x19, 0x76, 0xe0, 0x0e, 0x88, 0xdb, 0x16, 0xaf, 0x3f, 0x89, 0xe2, 0xb6,
+	0x05, 0x4f, 0x41, 0x0a, 0x77, 0xf8, 0x99, 0x44, 0xc1, 0xda, 0x7a, 0xc1, 0x8b, 0x55, 0x2a, 0x1c,
+	0x89, 0xc2, 0x8c, 0xdf, 0xa9, 0x6b, 0x85, 0xae, 0xe4, 0x3a, 0x5d, 0x0a, 0x48, 0x7b, 0xbc, 0x26,
+	0x42, 0xf3, 0x1b, 0x3c, 0x3c, 0xb7, 0xe1, 0x1d, 0x90, 0xc1, 0x9e, 0x47, 0xdd, 0xe7, 0xd8, 0x61,
+	0xf9, 0x54, 0x31, 0x71, 0x94, 0x41, 0x0b, 0x07, 0xfc, 0x12, 0x64, 0xc5, 0x1d, 0x99, 0x41, 0xcf,
+	0xe7, 0x37, 0x3f, 0x7a, 0xdb, 0x85, 0x37, 0x53, 0x35, 0x36, 0x9b, 0xaa, 0x70, 0xf9, 0xc6, 0x79,
+	0xb2, 0xb8, 0xf2, 0xb0, 0x77, 0x82, 0x04, 0xed, 0x9b, 0x04, 0xd8, 0x7a, 0x64, 0x33, 0xdf, 0xa5,
+	0x23, 0x63, 0xe0, 0xd3, 0xd1, 0xdf, 0x86, 0xaf, 0xd5, 0xf6, 0xda, 0xf8, 0xe3, 0xda, 0x4b, 0x01,
+	0x69, 0xd7, 0x23, 0x14, 0xfb, 0x2e, 0xe5, 0xda, 0xc9, 0xa0, 0xb9, 0x0d, 0x6f, 0x83, 0x54, 0x8f,
+	0xd8, 0xdd, 0x9e, 0xcf, 0x79, 0x4e, 0xa0, 0xd0, 0x82, 0x9f, 0x80, 0x24, 0x67, 0x3f, 0xfd, 0x51,
+	0xf6, 0xd3, 0x01, 0xfb, 0x9c, 0x67, 0x9e, 0x01, 0x1f, 0x00, 0xd9, 0xa3, 0xe4, 0xb9, 0xed, 0x0e,
+	0x99, 0x19, 0xb1, 0x91, 0x09, 0x76, 0xd5, 0xff, 0x35, 0x9b, 0xaa, 0x07, 0xa2, 0xda, 0x55, 0x84,
+	0x86, 0x76, 0x23, 0x57, 0x25, 0xf4, 0x7c, 0x9f, 0x00, 0xa9, 0x0b, 0x4c, 0x71, 0x9f, 0xc1, 0x06,
+	0x80, 0x51, 0x7b, 0x98, 0x7e, 0x8f, 0x12, 0xd6, 0x73, 0x1d, 0x71, 0x67, 0xdb, 0xfa, 0xdd, 0xd9,
+	0x54, 0x3d, 0x0c, 0x29, 0x58, 0xc3, 0x68, 0x68, 0x2f, 0x72, 0xb6, 0x23, 0x1f, 0x74, 0xc0, 0x9e,
+	0x17, 0xaa, 0xc5, 0x74, 0xec, 0xa7, 0x84, 0x9f, 0x33, 0xce, 0xcf, 0x79, 0xb8, 0x76, 0xce, 0x5a,
+	0x38, 0x96, 0xf5, 0x7f, 0x87, 0x4d, 0x96, 0x8f, 0x0e, 0xb0, 0xb2, 0x82, 0xf6, 0x2a, 0xa0, 0x40,
+	0x8e, 0xfc, 0x8d, 0xd0, 0x0d, 0xff, 0x0f, 0x40, 0x1f, 0x5f, 0x9b, 0x2c, 0x18, 0xd5, 0xa2, 0x2d,
+	0xb6, 0xf5, 0x5b, 0x8b, 0xf1, 0xb4, 0x88, 0x69, 0x28, 0xd3, 0xc7, 0xd7, 0x7c, 0xa4, 0x33, 0xf8,
+	0x39, 0xb8, 0x1d, 0x44, 0x96, 0x1a, 0xc4, 0x74, 0xc8, 0xa0, 0xeb, 0xf7, 0x78, 0xeb, 0x6c, 0xeb,
+	0xf7, 0x66, 0x53, 0xf5, 0xee, 0x62, 0x85, 0x75, 0x9c, 0x86, 0x72, 0x7d, 0x7c, 0x5d, 0x5b, 0xf8,
+	0x1b, 0xdc, 0x0d, 0x9f, 0x80, 0x03, 0x97, 0x5a, 0xf6, 0x00, 0xd3, 0x91, 0xd9, 0xc1, 0x83, 0x80,
+	0xff, 0xa8, 0xb6, 0xa0, 0xe3, 0xd2, 0xba, 0x36, 0x9b, 0xaa, 0x05, 0xb1, 0xf2, 0x07, 0x80, 0x1a,
+	0xca, 0x45, 0x91, 0x2a, 0x1e, 0x54, 0x2c, 0x4b, 0xd4, 0x7c, 0x9a, 0x7c, 0xf5, 0x5a, 0x8d, 0x69,
+	0xaf, 0xe2, 0x40, 0x8e, 0x7c, 0xf3, 0xa1, 0x94, 0x03, 0x1b, 0xbe, 0xed, 0x3b, 0x24, 0x9c, 0xab,
+	0xc2, 0x58, 0x15, 0x45, 0x7c, 0x5d, 0x14, 0x1f, 0x16, 0x54, 0x1d, 0xec, 0xf1, 0x6a, 0xcc, 0x35,
+	0x59, 0xe9, 0x77, 0x16, 0xb7, 0xb4, 0x06, 0xd1, 0x90, 0xcc, 0x7d, 0xb5, 0xbf, 0x44, 0x79, 0xa7,
+	0x5b, 0x2f, 0x5e, 0xab, 0xb1, 0x80, 0x96, 0x5f, 0x03, 0x6a, 0x86, 0x60, 0xbf, 0x46, 0x1c, 0xe2,
+	0x93, 0x3f, 0x99, 0x9c, 0x95, 0x6d, 0xbf, 0x95, 0x40, 0xe6, 0x02, 0x0f, 0x19, 0xb1, 0x1e, 0xb3,
+	0x2e, 0x2c, 0x81, 0x74, 0x50, 0xa8, 0x39, 0xa4, 0x8e, 0xd8, 0x50, 0xdf, 0x9f, 0x4d, 0xd5, 0x5d,
+	0x71, 0x94, 0x28, 0xa2, 0xa1, 0xcd, 0xe0, 0xf3, 0x92, 0x3a, 0xf0, 0x53, 0xb0, 0xcd, 0xc7, 0xe7,
+	0xc8, 0x0c, 0xe7, 0x44, 0x50, 0x49, 0x42, 0xcf, 0xcf, 0xa6, 0x6a, 0x6e, 0x69, 0xde, 0x46, 0x61,
+	0x0d, 0x6d, 0x09, 0xfb, 0x11, 0x37, 0xe1, 0x7d, 0x90, 0xf1, 0xf8, 0xde, 0xc1, 0x0b, 0xc6, 0xcb,
+	0xd4, 0x73, 0xb3, 0xa9, 0x2a, 0x87, 0x2a, 0x8a, 0x42, 0x1a, 0x4a, 0x8b, 0x6f, 0x7d, 0xa4, 0x5d,
+	0x83, 0x1d, 0xdd, 0x71, 0x3b, 0xcf, 0x88, 0x15, 0x12, 0xbe, 0x7c, 0x52, 0xe9, 0xfd, 0x36, 0xb8,
+	0x0d, 0x52, 0x94, 0x60, 0x36, 0x27, 0x28, 0xb4, 0x02, 0xd5, 0x5d, 0x89, 0x35, 0x16, 0xfb, 0x2e,
+	0xa9, 0x6e, 0x11, 0xd3, 0x50, 0x26, 0x34, 0xf4, 0xd1, 0x71, 0x1d, 0x64, 0x2b, 0xef, 0x3f, 0xcb,
+	0x0f, 0x8d, 0x73, 0xa3, 0x55, 0x6f, 0xc9, 0x31, 0x25, 0x3b, 0x9e, 0x14, 0x37, 0x1f, 0x92, 0x01,
+	0x61, 0x36, 0x0b, 0x26, 0x6a, 0x13, 0xd5, 0xea, 0xe7, 0x15, 0xf4, 0x44, 0x96, 0x94, 0xad, 0xf1,
+	0xa4, 0x98, 0x6e, 0x86, 0x92, 0x50, 0x92, 0x2f, 0xbe, 0x2e, 0xc4, 0x8e, 0x6f, 0x24, 0xb0, 0xc1,
+	0xff, 0x61, 0xe0, 0x7f, 0xc1, 0x5e, 0xab, 0xda, 0xbc, 0x30, 0xcc, 0xcb, 0xf3, 0xd6, 0x85, 0x51,
+	0xad, 0x3f, 0xa8, 0x1b, 0x35, 0x39, 0xa6, 0xe4, 0xc6, 0x93, 0xa2, 0xcc, 0x11, 0x97, 0x03, 0xe6,
+	0x91, 0x8e, 0xfd, 0xd4, 0x26, 0x16, 0xbc, 0x07, 0xb6, 0x04, 0xb8, 0x89, 0x2a, 0xd5, 0x86, 0x21,
+	0x4b, 0xca, 0xee, 0x78, 0x52, 0xcc, 0x72, 0x5c, 0x93, 0xe2, 0x8e, 0x43, 0xe0, 0x09, 0xb8, 0x25,
+	0x20, 0xd5, 0x3a, 0xaa, 0x5e, 0xd6, 0xdb, 0xa6, 0x8e, 0x8c, 0xca, 0x99, 0x81, 0xe4, 0xa4, 0x72,
+	0x30, 0x9e, 0x14, 0xf7, 0x39, 0xb6, 0x6a, 0xd3, 0xce, 0xd0, 0xf6, 0x75, 0x4a, 0xf0, 0x33, 0x42,
+	0xe1, 0x7f, 0xc0, 0xae, 0xc8, 0xd1, 0x1b, 0xcd, 0xea, 0x59, 0xa3, 0xde, 0x6a, 0xcb, 0x1b, 0x0a,
+	0x1c, 0x4f, 0x8a, 0x3b, 0x1c, 0xcd, 0xe9, 0x76, 0x6c, 0xe6, 0x8b, 0xe2, 0xb5, 0x64, 0x3a, 0x2e,
+	0xc7, 0xb5, 0x64, 0x3a, 0x21, 0x27, 0x8e, 0xb7, 0x45, 0x62, 0xcb, 0x40, 0x9f, 0xd5, 0xab, 0xc6,
+	0x71, 0x56, 0x98, 0xed, 0xe6, 0x99, 0x71, 0x7e, 0xfc, 0x95, 0x04, 0xe4, 0xd5, 0xc7, 0x10, 0x1e,
+	0x01, 0xb9, 0x52, 0x6d, 0xd7, 0x9b, 0xe7, 0x66, 0xa5, 0x56, 0x33, 0x5b, 0x97, 0x17, 0x06, 0x92,
+	0x63, 0x62, 0x33, 0x81, 0x88, 0xa6, 0x03, 0x2c, 0x81, 0xfd, 0x10, 0x59, 0x33, 0x1a, 0x46, 0xdb,
+	0x08, 0xc1, 0x92, 0x72, 0x6b, 0x3c, 0x29, 0xee, 0x09, 0xf0, 0x92, 0x60, 0x96, 0xf0, 0xa8, 0xd9,
+	0xae, 0xcc, 0xf1, 0xf1, 0x65, 0x3c, 0x72, 0x7d, 0x1c, 0xe2, 0xc5, 0x61, 0xf4, 0xb3, 0x37, 0x37,
+	0x05, 0xe9, 0xed, 0x4d, 0x41, 0xfa, 0xe5, 0xa6, 0x20, 0xbd, 0x7c, 0x57, 0x88, 0xbd, 0x7d, 0x57,
+	0x88, 0xfd, 0xf4, 0xae, 0x10, 0xfb, 0xe2, 0x7e, 0xd7, 0xf6, 0x03, 0x81, 0x77, 0xdc, 0x7e, 0x39,
+	0x10, 0xfb, 0x80, 0xf8, 0xe5, 0x50, 0xf4, 0xe5, 0xbe, 0x6b, 0x0d, 0x1d, 0xc2, 0xe6, 0xbf, 0xf7,
+	0xe5, 0x40, 0x0f, 0xec, 0x2a, 0xc5, 0x1f, 0x86, 0xff, 0xfd, 0x36, 0x00, 0xe7, 0xb6, 0xd0, 0x0a,
+	0x00, 0x0c, 0x00, 0x00,
+}
+
+func (m *Super) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Super) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Super) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGuardian(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Scopes) > 0 {
+		dAtA3 := make([]byte, len(m.Scopes)*10)
+		var j2 int
+		for _, num := range m.Scopes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGuardian(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Trustee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trustee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trustee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MembershipProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MembershipProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MembershipProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGuardian(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintGuardian(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousAddress) > 0 {
+		i -= len(m.PreviousAddress)
+		copy(dAtA[i:], m.PreviousAddress)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.PreviousAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGuardian(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrdinaryCanAddSupers {
+		i--
+		if m.OrdinaryCanAddSupers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxDescriptionLength != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.MaxDescriptionLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxSupers != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.MaxSupers))
+		i--
+		dAtA[i] = 0x18
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ProposalLifetime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProposalLifetime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGuardian(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.ApprovalThreshold != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ApprovalThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddSuperProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddSuperProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddSuperProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountType != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SuperDescription) > 0 {
+		i -= len(m.SuperDescription)
+		copy(dAtA[i:], m.SuperDescription)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.SuperDescription)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteSuperProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteSuperProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteSuperProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PausedMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGuardian(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedBy) > 0 {
+		i -= len(m.BlockedBy)
+		copy(dAtA[i:], m.BlockedBy)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.BlockedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGuardian(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuardian(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Super) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovGuardian(uint64(m.AccountType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Scopes) > 0 {
+		l = 0
+		for _, e := range m.Scopes {
+			l += sovGuardian(uint64(e))
+		}
+		n += 1 + sovGuardian(uint64(l)) + l
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *Trustee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovGuardian(uint64(m.AccountType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *MembershipProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGuardian(uint64(m.Id))
+	}
+	if m.Action != 0 {
+		n += 1 + sovGuardian(uint64(m.Action))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovGuardian(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireTime)
+	n += 1 + l + sovGuardian(uint64(l))
+	return n
+}
+
+func (m *HistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGuardian(uint64(m.Id))
+	}
+	if m.Action != 0 {
+		n += 1 + sovGuardian(uint64(m.Action))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovGuardian(uint64(m.AccountType))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGuardian(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGuardian(uint64(l))
+	l = len(m.PreviousAddress)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApprovalThreshold != 0 {
+		n += 1 + sovGuardian(uint64(m.ApprovalThreshold))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ProposalLifetime)
+	n += 1 + l + sovGuardian(uint64(l))
+	if m.MaxSupers != 0 {
+		n += 1 + sovGuardian(uint64(m.MaxSupers))
+	}
+	if m.MaxDescriptionLength != 0 {
+		n += 1 + sovGuardian(uint64(m.MaxDescriptionLength))
+	}
+	if m.OrdinaryCanAddSupers {
+		n += 2
+	}
+	return n
+}
+
+func (m *AddSuperProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.SuperDescription)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovGuardian(uint64(m.AccountType))
+	}
+	return n
+}
+
+func (m *DeleteSuperProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *PausedMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGuardian(uint64(m.ExpiryHeight))
+	}
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	l = len(m.BlockedBy)
+	if l > 0 {
+		n += 1 + l + sovGuardian(uint64(l))
+	}
+	return n
+}
+
+func sovGuardian(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuardian(x uint64) (n int) {
+	return sovGuardian(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Super) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Super: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Super: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= AccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v Scope
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGuardian
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Scope(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Scopes = append(m.Scopes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGuardian
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGuardian
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGuardian
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Scopes) == 0 {
+					m.Scopes = make([]Scope, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Scope
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGuardian
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Scope(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Scopes = append(m.Scopes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trustee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trustee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trustee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= AccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= MembershipAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= MembershipAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= AccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalThreshold", wireType)
+			}
+			m.ApprovalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalLifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ProposalLifetime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupers", wireType)
+			}
+			m.MaxSupers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDescriptionLength", wireType)
+			}
+			m.MaxDescriptionLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDescriptionLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdinaryCanAddSupers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OrdinaryCanAddSupers = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddSuperProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSuperProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSuperProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperDescription", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuperDescription = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= AccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteSuperProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteSuperProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteSuperProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGuardian(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGuardian(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Super) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
-	}
-	if m.AccountType != 0 {
-		n += 1 + sovGuardian(uint64(m.AccountType))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
-	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovGuardian(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGuardian(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGuardian(x uint64) (n int) {
-	return sovGuardian(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Super) Unmarshal(dAtA []byte) error {
+func (m *PausedMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -265,15 +3170,15 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Super: wiretype end group for non-group")
+			return fmt.Errorf("proto: PausedMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Super: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PausedMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -301,13 +3206,13 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			m.AccountType = 0
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGuardian
@@ -317,12 +3222,97 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountType |= AccountType(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardian(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardian
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -354,9 +3344,9 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -384,7 +3374,39 @@ func (m *Super) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardian
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardian
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
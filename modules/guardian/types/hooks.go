@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GuardianHooks defines the event hooks which other modules are able to register for guardian
+type GuardianHooks interface {
+	// AfterSuperAdded is called after a super is added, including the new address of a rotated super
+	AfterSuperAdded(ctx sdk.Context, address sdk.AccAddress)
+	// AfterSuperRemoved is called after a super is deleted or expired, including the previous
+	// address of a rotated super
+	AfterSuperRemoved(ctx sdk.Context, address sdk.AccAddress)
+}
+
+var _ GuardianHooks = MultiGuardianHooks{}
+
+// MultiGuardianHooks combines multiple guardian hooks, all hook functions are run in array sequence
+type MultiGuardianHooks []GuardianHooks
+
+// NewMultiGuardianHooks creates a new MultiGuardianHooks
+func NewMultiGuardianHooks(hooks ...GuardianHooks) MultiGuardianHooks {
+	return hooks
+}
+
+// AfterSuperAdded implements GuardianHooks
+func (h MultiGuardianHooks) AfterSuperAdded(ctx sdk.Context, address sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterSuperAdded(ctx, address)
+	}
+}
+
+// AfterSuperRemoved implements GuardianHooks
+func (h MultiGuardianHooks) AfterSuperRemoved(ctx sdk.Context, address sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterSuperRemoved(ctx, address)
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,11 +21,31 @@ const (
 	QuerierRoute = StoreKey
 
 	// Query endpoints supported by the guardian querier
-	QuerySupers = "supers"
+	QuerySuper               = "super"
+	QuerySupers              = "supers"
+	QuerySuperDescendants    = "super_descendants"
+	QueryTrustee             = "trustee"
+	QueryTrustees            = "trustees"
+	QueryExpiringSupers      = "expiring_supers"
+	QueryHistory             = "history"
+	QueryMembershipProposals = "proposals"
+	QueryMembershipProposal  = "proposal"
+	QueryParams              = "params"
+	QueryPausedMsgs          = "paused_msgs"
+	QueryBlockedAccount      = "blocked_account"
+	QueryBlockedAccounts     = "blocked_accounts"
 )
 
 var (
-	SuperKey = []byte{0x00} // super key
+	SuperKey              = []byte{0x00} // super key
+	MembershipProposalKey = []byte{0x01} // membership proposal key
+	NextProposalIDKey     = []byte{0x02} // key for the next membership proposal id
+	ExpiringSuperKey      = []byte{0x03} // key for the expiration queue of supers
+	HistoryKey            = []byte{0x04} // key for the membership change log
+	NextHistoryIDKey      = []byte{0x05} // key for the next history entry id
+	TrusteeKey            = []byte{0x06} // trustee key
+	PausedMsgKey          = []byte{0x07} // key for the message types paused by the circuit breaker
+	BlockedAccountKey     = []byte{0x08} // key for the account blocklist
 )
 
 // GetSuperKey returns super key bytes
@@ -35,3 +57,58 @@ func GetSuperKey(addr sdk.AccAddress) []byte {
 func GetSupersSubspaceKey() []byte {
 	return SuperKey
 }
+
+// GetTrusteeKey returns trustee key bytes
+func GetTrusteeKey(addr sdk.AccAddress) []byte {
+	return append(TrusteeKey, addr.Bytes()...)
+}
+
+// GetTrusteesSubspaceKey returns the key for getting all trustees from the store
+func GetTrusteesSubspaceKey() []byte {
+	return TrusteeKey
+}
+
+// GetPausedMsgKey returns the key of the paused message type
+func GetPausedMsgKey(typeURL string) []byte {
+	return append(PausedMsgKey, []byte(typeURL)...)
+}
+
+// GetPausedMsgsSubspaceKey returns the key for getting all paused message types from the store
+func GetPausedMsgsSubspaceKey() []byte {
+	return PausedMsgKey
+}
+
+// GetBlockedAccountKey returns the key of the blocked account
+func GetBlockedAccountKey(addr sdk.AccAddress) []byte {
+	return append(BlockedAccountKey, addr.Bytes()...)
+}
+
+// GetBlockedAccountsSubspaceKey returns the key for getting all blocked accounts from the store
+func GetBlockedAccountsSubspaceKey() []byte {
+	return BlockedAccountKey
+}
+
+// GetMembershipProposalKey returns the membership proposal key bytes
+func GetMembershipProposalKey(id uint64) []byte {
+	return append(MembershipProposalKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetMembershipProposalsSubspaceKey returns the key for getting all membership proposals from the store
+func GetMembershipProposalsSubspaceKey() []byte {
+	return MembershipProposalKey
+}
+
+// GetExpiringSuperKey returns the key of the super in the expiration queue
+func GetExpiringSuperKey(expiresAt time.Time, addr sdk.AccAddress) []byte {
+	return append(GetExpiringSupersByTimeKey(expiresAt), addr.Bytes()...)
+}
+
+// GetExpiringSupersByTimeKey returns the key prefix of the supers expiring at the given time
+func GetExpiringSupersByTimeKey(expiresAt time.Time) []byte {
+	return append(ExpiringSuperKey, sdk.FormatTimeBytes(expiresAt)...)
+}
+
+// GetHistoryKey returns the history entry key bytes
+func GetHistoryKey(id uint64) []byte {
+	return append(HistoryKey, sdk.Uint64ToBigEndian(id)...)
+}
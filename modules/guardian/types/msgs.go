@@ -8,11 +8,45 @@ import (
 const (
 	TypeMsgAddSuper    = "add_super"    // type for MsgAddSuper
 	TypeMsgDeleteSuper = "delete_super" // type for MsgDeleteSuper
+	TypeMsgGrantScope  = "grant_scope"  // type for MsgGrantScope
+	TypeMsgRevokeScope = "revoke_scope" // type for MsgRevokeScope
+	TypeMsgRotateSuper = "rotate_super" // type for MsgRotateSuper
+	TypeMsgUpdateSuper = "update_super" // type for MsgUpdateSuper
+
+	TypeMsgPauseMsgs   = "pause_msgs"   // type for MsgPauseMsgs
+	TypeMsgUnpauseMsgs = "unpause_msgs" // type for MsgUnpauseMsgs
+
+	TypeMsgBlockAccount   = "block_account"   // type for MsgBlockAccount
+	TypeMsgUnblockAccount = "unblock_account" // type for MsgUnblockAccount
+
+	TypeMsgSubmitMembershipProposal  = "submit_membership_proposal"  // type for MsgSubmitMembershipProposal
+	TypeMsgApproveMembershipProposal = "approve_membership_proposal" // type for MsgApproveMembershipProposal
+	TypeMsgExecuteMembershipProposal = "execute_membership_proposal" // type for MsgExecuteMembershipProposal
+
+	TypeMsgAddTrustee         = "add_trustee"          // type for MsgAddTrustee
+	TypeMsgDeleteTrustee      = "delete_trustee"       // type for MsgDeleteTrustee
+	TypeMsgSpendCommunityPool = "spend_community_pool" // type for MsgSpendCommunityPool
+
+	MaxDescriptionLength = 280 // upper bound of the description length, the effective limit is the MaxDescriptionLength param
 )
 
 var (
 	_ sdk.Msg = &MsgAddSuper{}
 	_ sdk.Msg = &MsgDeleteSuper{}
+	_ sdk.Msg = &MsgGrantScope{}
+	_ sdk.Msg = &MsgRevokeScope{}
+	_ sdk.Msg = &MsgSubmitMembershipProposal{}
+	_ sdk.Msg = &MsgApproveMembershipProposal{}
+	_ sdk.Msg = &MsgExecuteMembershipProposal{}
+	_ sdk.Msg = &MsgAddTrustee{}
+	_ sdk.Msg = &MsgDeleteTrustee{}
+	_ sdk.Msg = &MsgSpendCommunityPool{}
+	_ sdk.Msg = &MsgRotateSuper{}
+	_ sdk.Msg = &MsgUpdateSuper{}
+	_ sdk.Msg = &MsgPauseMsgs{}
+	_ sdk.Msg = &MsgUnpauseMsgs{}
+	_ sdk.Msg = &MsgBlockAccount{}
+	_ sdk.Msg = &MsgUnblockAccount{}
 )
 
 // NewMsgAddSuper constructs a MsgAddSuper
@@ -50,6 +84,9 @@ func (msg MsgAddSuper) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.AddedBy); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
 	}
+	if msg.ExpiresAt != nil && msg.ExpiresAt.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpiration, "expiration time must not be zero")
+	}
 	if err := msg.EnsureLength(); err != nil {
 		return err
 	}
@@ -68,10 +105,11 @@ func (msg MsgAddSuper) GetSigners() []sdk.AccAddress {
 // ______________________________________________________________________
 
 // NewMsgDeleteSuper constructs a MsgDeleteSuper
-func NewMsgDeleteSuper(address, deletedBy sdk.AccAddress) *MsgDeleteSuper {
+func NewMsgDeleteSuper(address, deletedBy sdk.AccAddress, cascade bool) *MsgDeleteSuper {
 	return &MsgDeleteSuper{
 		Address:   address.String(),
 		DeletedBy: deletedBy.String(),
+		Cascade:   cascade,
 	}
 }
 
@@ -110,10 +148,707 @@ func (msg MsgDeleteSuper) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// ______________________________________________________________________
+
+// NewMsgGrantScope constructs a MsgGrantScope
+func NewMsgGrantScope(address sdk.AccAddress, scope Scope, grantedBy sdk.AccAddress) *MsgGrantScope {
+	return &MsgGrantScope{
+		Address:   address.String(),
+		Scope:     scope,
+		GrantedBy: grantedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgGrantScope) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgGrantScope) Type() string { return TypeMsgGrantScope }
+
+// GetSignBytes implements Msg.
+func (msg MsgGrantScope) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgGrantScope) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.GrantedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if !ValidScope(msg.Scope) {
+		return sdkerrors.Wrap(ErrInvalidScope, msg.Scope.String())
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgGrantScope) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.GrantedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgRevokeScope constructs a MsgRevokeScope
+func NewMsgRevokeScope(address sdk.AccAddress, scope Scope, revokedBy sdk.AccAddress) *MsgRevokeScope {
+	return &MsgRevokeScope{
+		Address:   address.String(),
+		Scope:     scope,
+		RevokedBy: revokedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRevokeScope) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRevokeScope) Type() string { return TypeMsgRevokeScope }
+
+// GetSignBytes implements Msg.
+func (msg MsgRevokeScope) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRevokeScope) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.RevokedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if !ValidScope(msg.Scope) {
+		return sdkerrors.Wrap(ErrInvalidScope, msg.Scope.String())
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgRevokeScope) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.RevokedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgSubmitMembershipProposal constructs a MsgSubmitMembershipProposal
+func NewMsgSubmitMembershipProposal(action MembershipAction, address sdk.AccAddress, description string, proposer sdk.AccAddress) *MsgSubmitMembershipProposal {
+	return &MsgSubmitMembershipProposal{
+		Action:      action,
+		Address:     address.String(),
+		Description: description,
+		Proposer:    proposer.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSubmitMembershipProposal) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSubmitMembershipProposal) Type() string { return TypeMsgSubmitMembershipProposal }
+
+// GetSignBytes implements Msg.
+func (msg MsgSubmitMembershipProposal) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSubmitMembershipProposal) ValidateBasic() error {
+	if !ValidMembershipAction(msg.Action) {
+		return sdkerrors.Wrap(ErrInvalidAction, msg.Action.String())
+	}
+	if msg.Action == ActionAddSuper && len(msg.Description) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "description missing")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address (%s)", err)
+	}
+	return msg.EnsureLength()
+}
+
+// GetSigners implements Msg.
+func (msg MsgSubmitMembershipProposal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// EnsureLength validate the length of MsgSubmitMembershipProposal
+func (msg MsgSubmitMembershipProposal) EnsureLength() error {
+	if len(msg.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid description length; got: %d, max: %d", len(msg.Description), MaxDescriptionLength)
+	}
+	return nil
+}
+
+// ______________________________________________________________________
+
+// NewMsgApproveMembershipProposal constructs a MsgApproveMembershipProposal
+func NewMsgApproveMembershipProposal(proposalID uint64, approver sdk.AccAddress) *MsgApproveMembershipProposal {
+	return &MsgApproveMembershipProposal{
+		ProposalId: proposalID,
+		Approver:   approver.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgApproveMembershipProposal) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgApproveMembershipProposal) Type() string { return TypeMsgApproveMembershipProposal }
+
+// GetSignBytes implements Msg.
+func (msg MsgApproveMembershipProposal) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgApproveMembershipProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Approver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid approver address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgApproveMembershipProposal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Approver)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgExecuteMembershipProposal constructs a MsgExecuteMembershipProposal
+func NewMsgExecuteMembershipProposal(proposalID uint64, executor sdk.AccAddress) *MsgExecuteMembershipProposal {
+	return &MsgExecuteMembershipProposal{
+		ProposalId: proposalID,
+		Executor:   executor.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgExecuteMembershipProposal) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgExecuteMembershipProposal) Type() string { return TypeMsgExecuteMembershipProposal }
+
+// GetSignBytes implements Msg.
+func (msg MsgExecuteMembershipProposal) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgExecuteMembershipProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Executor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid executor address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgExecuteMembershipProposal) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Executor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 // EnsureLength validate the length of AddGuardian
 func (msg MsgAddSuper) EnsureLength() error {
-	if len(msg.Description) > 70 {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website length; got: %d, max: %d", len(msg.Description), 70)
+	if len(msg.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid website length; got: %d, max: %d", len(msg.Description), MaxDescriptionLength)
+	}
+	return nil
+}
+
+// ______________________________________________________________________
+
+// NewMsgAddTrustee constructs a MsgAddTrustee
+func NewMsgAddTrustee(description string, address, addedBy sdk.AccAddress) *MsgAddTrustee {
+	return &MsgAddTrustee{
+		Description: description,
+		Address:     address.String(),
+		AddedBy:     addedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgAddTrustee) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgAddTrustee) Type() string { return TypeMsgAddTrustee }
+
+// GetSignBytes implements Msg.
+func (msg MsgAddTrustee) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgAddTrustee) ValidateBasic() error {
+	if len(msg.Description) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "description missing")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.AddedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return msg.EnsureLength()
+}
+
+// GetSigners implements Msg.
+func (msg MsgAddTrustee) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.AddedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// EnsureLength validate the length of MsgAddTrustee
+func (msg MsgAddTrustee) EnsureLength() error {
+	if len(msg.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid description length; got: %d, max: %d", len(msg.Description), MaxDescriptionLength)
+	}
+	return nil
+}
+
+// ______________________________________________________________________
+
+// NewMsgDeleteTrustee constructs a MsgDeleteTrustee
+func NewMsgDeleteTrustee(address, deletedBy sdk.AccAddress) *MsgDeleteTrustee {
+	return &MsgDeleteTrustee{
+		Address:   address.String(),
+		DeletedBy: deletedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgDeleteTrustee) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgDeleteTrustee) Type() string { return TypeMsgDeleteTrustee }
+
+// GetSignBytes implements Msg.
+func (msg MsgDeleteTrustee) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgDeleteTrustee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.DeletedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgDeleteTrustee) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.DeletedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgSpendCommunityPool constructs a MsgSpendCommunityPool
+func NewMsgSpendCommunityPool(recipient sdk.AccAddress, amount sdk.Coins, trustee sdk.AccAddress) *MsgSpendCommunityPool {
+	return &MsgSpendCommunityPool{
+		Recipient: recipient.String(),
+		Amount:    amount,
+		Trustee:   trustee.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgSpendCommunityPool) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgSpendCommunityPool) Type() string { return TypeMsgSpendCommunityPool }
+
+// GetSignBytes implements Msg.
+func (msg MsgSpendCommunityPool) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgSpendCommunityPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Trustee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid trustee address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgSpendCommunityPool) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Trustee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgRotateSuper constructs a MsgRotateSuper
+func NewMsgRotateSuper(address, newAddress sdk.AccAddress) *MsgRotateSuper {
+	return &MsgRotateSuper{
+		Address:    address.String(),
+		NewAddress: newAddress.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgRotateSuper) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgRotateSuper) Type() string { return TypeMsgRotateSuper }
+
+// GetSignBytes implements Msg.
+func (msg MsgRotateSuper) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgRotateSuper) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new address (%s)", err)
+	}
+	if msg.Address == msg.NewAddress {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new address must differ from the current address")
 	}
 	return nil
 }
+
+// GetSigners implements Msg.
+func (msg MsgRotateSuper) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgUpdateSuper constructs a MsgUpdateSuper
+func NewMsgUpdateSuper(description string, address, updatedBy sdk.AccAddress) *MsgUpdateSuper {
+	return &MsgUpdateSuper{
+		Address:     address.String(),
+		Description: description,
+		UpdatedBy:   updatedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUpdateSuper) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUpdateSuper) Type() string { return TypeMsgUpdateSuper }
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateSuper) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateSuper) ValidateBasic() error {
+	if len(msg.Description) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "description missing")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.UpdatedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return msg.EnsureLength()
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateSuper) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.UpdatedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// EnsureLength validate the length of MsgUpdateSuper
+func (msg MsgUpdateSuper) EnsureLength() error {
+	if len(msg.Description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid description length; got: %d, max: %d", len(msg.Description), MaxDescriptionLength)
+	}
+	return nil
+}
+
+// ______________________________________________________________________
+
+// NewMsgPauseMsgs constructs a MsgPauseMsgs
+func NewMsgPauseMsgs(typeURLs []string, expiryHeight int64, pausedBy sdk.AccAddress) *MsgPauseMsgs {
+	return &MsgPauseMsgs{
+		TypeUrls:     typeURLs,
+		ExpiryHeight: expiryHeight,
+		PausedBy:     pausedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgPauseMsgs) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgPauseMsgs) Type() string { return TypeMsgPauseMsgs }
+
+// GetSignBytes implements Msg.
+func (msg MsgPauseMsgs) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgPauseMsgs) ValidateBasic() error {
+	if err := ValidatePausableTypeURLs(msg.TypeUrls); err != nil {
+		return err
+	}
+	if msg.ExpiryHeight < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "expiry height must not be negative: %d", msg.ExpiryHeight)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.PausedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgPauseMsgs) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.PausedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgUnpauseMsgs constructs a MsgUnpauseMsgs
+func NewMsgUnpauseMsgs(typeURLs []string, unpausedBy sdk.AccAddress) *MsgUnpauseMsgs {
+	return &MsgUnpauseMsgs{
+		TypeUrls:   typeURLs,
+		UnpausedBy: unpausedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUnpauseMsgs) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUnpauseMsgs) Type() string { return TypeMsgUnpauseMsgs }
+
+// GetSignBytes implements Msg.
+func (msg MsgUnpauseMsgs) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUnpauseMsgs) ValidateBasic() error {
+	if err := ValidatePausableTypeURLs(msg.TypeUrls); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.UnpausedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgUnpauseMsgs) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.UnpausedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// ______________________________________________________________________
+
+// NewMsgBlockAccount constructs a MsgBlockAccount
+func NewMsgBlockAccount(address sdk.AccAddress, reason string, blockedBy sdk.AccAddress) *MsgBlockAccount {
+	return &MsgBlockAccount{
+		Address:   address.String(),
+		Reason:    reason,
+		BlockedBy: blockedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgBlockAccount) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgBlockAccount) Type() string { return TypeMsgBlockAccount }
+
+// GetSignBytes implements Msg.
+func (msg MsgBlockAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgBlockAccount) ValidateBasic() error {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	blockedBy, err := sdk.AccAddressFromBech32(msg.BlockedBy)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	if address.Equals(blockedBy) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "operator can not block itself")
+	}
+	return msg.EnsureLength()
+}
+
+// GetSigners implements Msg.
+func (msg MsgBlockAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.BlockedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// EnsureLength validate the length of MsgBlockAccount
+func (msg MsgBlockAccount) EnsureLength() error {
+	if len(msg.Reason) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reason length; got: %d, max: %d", len(msg.Reason), MaxDescriptionLength)
+	}
+	return nil
+}
+
+// ______________________________________________________________________
+
+// NewMsgUnblockAccount constructs a MsgUnblockAccount
+func NewMsgUnblockAccount(address, unblockedBy sdk.AccAddress) *MsgUnblockAccount {
+	return &MsgUnblockAccount{
+		Address:     address.String(),
+		UnblockedBy: unblockedBy.String(),
+	}
+}
+
+// Route implements Msg.
+func (msg MsgUnblockAccount) Route() string { return RouterKey }
+
+// Type implements Msg.
+func (msg MsgUnblockAccount) Type() string { return TypeMsgUnblockAccount }
+
+// GetSignBytes implements Msg.
+func (msg MsgUnblockAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUnblockAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.UnblockedBy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+	}
+	return nil
+}
+
+// GetSigners implements Msg.
+func (msg MsgUnblockAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.UnblockedBy)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -73,6 +75,7 @@ func TestMsgAddSuperValidation(t *testing.T) {
 		{"invalid Description", false, NewMsgAddSuper(nilDescription, testAddr, sender)},
 		{"invalid Address", false, NewMsgAddSuper(description, nilAddr, sender)},
 		{"invalid AddedBy", false, NewMsgAddSuper(description, testAddr, nilAddr)},
+		{"invalid ExpiresAt", false, &MsgAddSuper{Description: description, Address: testAddr.String(), AddedBy: sender.String(), ExpiresAt: &time.Time{}}},
 	}
 
 	for _, tc := range tests {
@@ -92,30 +95,32 @@ func TestMsgAddSuperValidation(t *testing.T) {
 // ----------------------------------------------
 
 func TestNewMsgDeleteSuper(t *testing.T) {
-	msg := NewMsgDeleteSuper(testAddr, sender)
+	msg := NewMsgDeleteSuper(testAddr, sender, false)
 	require.Equal(t, testAddr.String(), msg.Address)
 	require.Equal(t, sender.String(), msg.DeletedBy)
+	require.False(t, msg.Cascade)
+	require.True(t, NewMsgDeleteSuper(testAddr, sender, true).Cascade)
 }
 
 func TestMsgDeleteSuperRoute(t *testing.T) {
-	msg := NewMsgDeleteSuper(testAddr, sender)
+	msg := NewMsgDeleteSuper(testAddr, sender, false)
 	require.Equal(t, RouterKey, msg.Route())
 }
 
 func TestMsgDeleteSuperType(t *testing.T) {
-	msg := NewMsgDeleteSuper(testAddr, sender)
+	msg := NewMsgDeleteSuper(testAddr, sender, false)
 	require.Equal(t, TypeMsgDeleteSuper, msg.Type())
 }
 
 func TestMsgDeleteSuperGetSignBytes(t *testing.T) {
-	msg := NewMsgDeleteSuper(testAddr, sender)
+	msg := NewMsgDeleteSuper(testAddr, sender, false)
 	res := msg.GetSignBytes()
 	expected := `{"type":"irishub/guardian/MsgDeleteSuper","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","deleted_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
 	require.Equal(t, expected, string(res))
 }
 
 func TestMsgDeleteSuperGetSigners(t *testing.T) {
-	msg := NewMsgDeleteSuper(testAddr, sender)
+	msg := NewMsgDeleteSuper(testAddr, sender, false)
 	res := msg.GetSigners()
 	expected := "[0A367B92CF0B037DFD89960EE832D56F7FC15168]"
 	require.Equal(t, expected, fmt.Sprintf("%v", res))
@@ -128,9 +133,9 @@ func TestMsgDeleteSuperValidation(t *testing.T) {
 		expectPass bool
 		msg        *MsgDeleteSuper
 	}{
-		{"pass", true, NewMsgDeleteSuper(testAddr, sender)},
-		{"invalid Address", false, NewMsgDeleteSuper(nilAddr, sender)},
-		{"invalid DeletedBy", false, NewMsgDeleteSuper(testAddr, nilAddr)},
+		{"pass", true, NewMsgDeleteSuper(testAddr, sender, false)},
+		{"invalid Address", false, NewMsgDeleteSuper(nilAddr, sender, false)},
+		{"invalid DeletedBy", false, NewMsgDeleteSuper(testAddr, nilAddr, false)},
 	}
 
 	for _, tc := range tests {
@@ -144,3 +149,421 @@ func TestMsgDeleteSuperValidation(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------
+// test MsgGrantScope
+// ----------------------------------------------
+
+func TestNewMsgGrantScope(t *testing.T) {
+	msg := NewMsgGrantScope(testAddr, ScopeOracle, sender)
+	require.Equal(t, testAddr.String(), msg.Address)
+	require.Equal(t, ScopeOracle, msg.Scope)
+	require.Equal(t, sender.String(), msg.GrantedBy)
+}
+
+func TestMsgGrantScopeRoute(t *testing.T) {
+	msg := NewMsgGrantScope(testAddr, ScopeOracle, sender)
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+func TestMsgGrantScopeType(t *testing.T) {
+	msg := NewMsgGrantScope(testAddr, ScopeOracle, sender)
+	require.Equal(t, TypeMsgGrantScope, msg.Type())
+}
+
+func TestMsgGrantScopeGetSignBytes(t *testing.T) {
+	msg := NewMsgGrantScope(testAddr, ScopeOracle, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgGrantScope","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","granted_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","scope":1}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgGrantScopeGetSigners(t *testing.T) {
+	msg := NewMsgGrantScope(testAddr, ScopeOracle, sender)
+	res := msg.GetSigners()
+	expected := "[0A367B92CF0B037DFD89960EE832D56F7FC15168]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
+// test ValidateBasic for MsgGrantScope
+func TestMsgGrantScopeValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgGrantScope
+	}{
+		{"pass", true, NewMsgGrantScope(testAddr, ScopeOracle, sender)},
+		{"invalid Address", false, NewMsgGrantScope(nilAddr, ScopeOracle, sender)},
+		{"invalid Scope", false, NewMsgGrantScope(testAddr, ScopeUnspecified, sender)},
+		{"invalid GrantedBy", false, NewMsgGrantScope(testAddr, ScopeOracle, nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgRevokeScope
+// ----------------------------------------------
+
+func TestNewMsgRevokeScope(t *testing.T) {
+	msg := NewMsgRevokeScope(testAddr, ScopeOracle, sender)
+	require.Equal(t, testAddr.String(), msg.Address)
+	require.Equal(t, ScopeOracle, msg.Scope)
+	require.Equal(t, sender.String(), msg.RevokedBy)
+}
+
+func TestMsgRevokeScopeRoute(t *testing.T) {
+	msg := NewMsgRevokeScope(testAddr, ScopeOracle, sender)
+	require.Equal(t, RouterKey, msg.Route())
+}
+
+func TestMsgRevokeScopeType(t *testing.T) {
+	msg := NewMsgRevokeScope(testAddr, ScopeOracle, sender)
+	require.Equal(t, TypeMsgRevokeScope, msg.Type())
+}
+
+func TestMsgRevokeScopeGetSignBytes(t *testing.T) {
+	msg := NewMsgRevokeScope(testAddr, ScopeOracle, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgRevokeScope","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","revoked_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","scope":1}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgRevokeScopeGetSigners(t *testing.T) {
+	msg := NewMsgRevokeScope(testAddr, ScopeOracle, sender)
+	res := msg.GetSigners()
+	expected := "[0A367B92CF0B037DFD89960EE832D56F7FC15168]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
+// test ValidateBasic for MsgRevokeScope
+func TestMsgRevokeScopeValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgRevokeScope
+	}{
+		{"pass", true, NewMsgRevokeScope(testAddr, ScopeOracle, sender)},
+		{"invalid Address", false, NewMsgRevokeScope(nilAddr, ScopeOracle, sender)},
+		{"invalid Scope", false, NewMsgRevokeScope(testAddr, Scope(9), sender)},
+		{"invalid RevokedBy", false, NewMsgRevokeScope(testAddr, ScopeOracle, nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgSubmitMembershipProposal
+// ----------------------------------------------
+
+func TestMsgSubmitMembershipProposalGetSignBytes(t *testing.T) {
+	msg := NewMsgSubmitMembershipProposal(ActionAddSuper, testAddr, description, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgSubmitMembershipProposal","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","description":"description","proposer":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgSubmitMembershipProposalGetSigners(t *testing.T) {
+	msg := NewMsgSubmitMembershipProposal(ActionAddSuper, testAddr, description, sender)
+	res := msg.GetSigners()
+	expected := "[0A367B92CF0B037DFD89960EE832D56F7FC15168]"
+	require.Equal(t, expected, fmt.Sprintf("%v", res))
+}
+
+// test ValidateBasic for MsgSubmitMembershipProposal
+func TestMsgSubmitMembershipProposalValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		msg        *MsgSubmitMembershipProposal
+	}{
+		{"pass add", true, NewMsgSubmitMembershipProposal(ActionAddSuper, testAddr, description, sender)},
+		{"pass delete", true, NewMsgSubmitMembershipProposal(ActionDeleteSuper, testAddr, nilDescription, sender)},
+		{"invalid Action", false, NewMsgSubmitMembershipProposal(MembershipAction(9), testAddr, description, sender)},
+		{"invalid Description", false, NewMsgSubmitMembershipProposal(ActionAddSuper, testAddr, nilDescription, sender)},
+		{"invalid Address", false, NewMsgSubmitMembershipProposal(ActionAddSuper, nilAddr, description, sender)},
+		{"invalid Proposer", false, NewMsgSubmitMembershipProposal(ActionAddSuper, testAddr, description, nilAddr)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+// ----------------------------------------------
+// test MsgApproveMembershipProposal
+// ----------------------------------------------
+
+func TestMsgApproveMembershipProposalGetSignBytes(t *testing.T) {
+	msg := NewMsgApproveMembershipProposal(1, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgApproveMembershipProposal","value":{"approver":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","proposal_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgApproveMembershipProposalValidation(t *testing.T) {
+	require.NoError(t, NewMsgApproveMembershipProposal(1, sender).ValidateBasic())
+	require.Error(t, NewMsgApproveMembershipProposal(1, nilAddr).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgExecuteMembershipProposal
+// ----------------------------------------------
+
+func TestMsgExecuteMembershipProposalGetSignBytes(t *testing.T) {
+	msg := NewMsgExecuteMembershipProposal(1, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgExecuteMembershipProposal","value":{"executor":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","proposal_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgExecuteMembershipProposalValidation(t *testing.T) {
+	require.NoError(t, NewMsgExecuteMembershipProposal(1, sender).ValidateBasic())
+	require.Error(t, NewMsgExecuteMembershipProposal(1, nilAddr).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgAddTrustee
+// ----------------------------------------------
+
+func TestMsgAddTrusteeGetSignBytes(t *testing.T) {
+	msg := NewMsgAddTrustee(description, testAddr, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgAddTrustee","value":{"added_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","description":"description"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgAddTrusteeGetSigners(t *testing.T) {
+	msg := NewMsgAddTrustee(description, testAddr, sender)
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{sender}, res)
+}
+
+func TestMsgAddTrusteeValidation(t *testing.T) {
+	require.NoError(t, NewMsgAddTrustee(description, testAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgAddTrustee(nilDescription, testAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgAddTrustee(description, nilAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgAddTrustee(description, testAddr, nilAddr).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgDeleteTrustee
+// ----------------------------------------------
+
+func TestMsgDeleteTrusteeGetSignBytes(t *testing.T) {
+	msg := NewMsgDeleteTrustee(testAddr, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgDeleteTrustee","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","deleted_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgDeleteTrusteeValidation(t *testing.T) {
+	require.NoError(t, NewMsgDeleteTrustee(testAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgDeleteTrustee(nilAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgDeleteTrustee(testAddr, nilAddr).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgSpendCommunityPool
+// ----------------------------------------------
+
+func TestMsgSpendCommunityPoolGetSignBytes(t *testing.T) {
+	msg := NewMsgSpendCommunityPool(testAddr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 100)), sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgSpendCommunityPool","value":{"amount":[{"amount":"100","denom":"uiris"}],"recipient":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","trustee":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgSpendCommunityPoolGetSigners(t *testing.T) {
+	msg := NewMsgSpendCommunityPool(testAddr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 100)), sender)
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{sender}, res)
+}
+
+func TestMsgSpendCommunityPoolValidation(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uiris", 100))
+	require.NoError(t, NewMsgSpendCommunityPool(testAddr, amount, sender).ValidateBasic())
+	require.Error(t, NewMsgSpendCommunityPool(nilAddr, amount, sender).ValidateBasic())
+	require.Error(t, NewMsgSpendCommunityPool(testAddr, sdk.Coins{}, sender).ValidateBasic())
+	require.Error(t, NewMsgSpendCommunityPool(testAddr, amount, nilAddr).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgRotateSuper
+// ----------------------------------------------
+
+func TestMsgRotateSuperGetSignBytes(t *testing.T) {
+	msg := NewMsgRotateSuper(sender, testAddr)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgRotateSuper","value":{"address":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","new_address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgRotateSuperGetSigners(t *testing.T) {
+	msg := NewMsgRotateSuper(sender, testAddr)
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{sender}, res)
+}
+
+func TestMsgRotateSuperValidation(t *testing.T) {
+	require.NoError(t, NewMsgRotateSuper(sender, testAddr).ValidateBasic())
+	require.Error(t, NewMsgRotateSuper(nilAddr, testAddr).ValidateBasic())
+	require.Error(t, NewMsgRotateSuper(sender, nilAddr).ValidateBasic())
+	require.Error(t, NewMsgRotateSuper(sender, sender).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgUpdateSuper
+// ----------------------------------------------
+
+func TestMsgUpdateSuperGetSignBytes(t *testing.T) {
+	msg := NewMsgUpdateSuper(description, testAddr, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgUpdateSuper","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","description":"description","updated_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgUpdateSuperGetSigners(t *testing.T) {
+	msg := NewMsgUpdateSuper(description, testAddr, sender)
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{sender}, res)
+}
+
+func TestMsgUpdateSuperValidation(t *testing.T) {
+	require.NoError(t, NewMsgUpdateSuper(description, testAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgUpdateSuper(nilDescription, testAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgUpdateSuper(strings.Repeat("a", MaxDescriptionLength+1), testAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgUpdateSuper(description, nilAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgUpdateSuper(description, testAddr, nilAddr).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgPauseMsgs
+// ----------------------------------------------
+
+var sendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+func TestMsgPauseMsgsGetSignBytes(t *testing.T) {
+	msg := NewMsgPauseMsgs([]string{sendTypeURL}, 100, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgPauseMsgs","value":{"expiry_height":"100","paused_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","type_urls":["/cosmos.bank.v1beta1.MsgSend"]}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgPauseMsgsGetSigners(t *testing.T) {
+	msg := NewMsgPauseMsgs([]string{sendTypeURL}, 100, sender)
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{sender}, res)
+}
+
+func TestMsgPauseMsgsValidation(t *testing.T) {
+	require.NoError(t, NewMsgPauseMsgs([]string{sendTypeURL}, 0, sender).ValidateBasic())
+	require.NoError(t, NewMsgPauseMsgs([]string{sendTypeURL}, 100, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs(nil, 0, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs([]string{sendTypeURL, sendTypeURL}, 0, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs([]string{"cosmos.bank.v1beta1.MsgSend"}, 0, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs([]string{"/"}, 0, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs([]string{"/irishub.guardian.MsgUnpauseMsgs"}, 0, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs([]string{"/cosmos.gov.v1beta1.MsgVote"}, 0, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs([]string{"/cosmos.gov.v1beta1.MsgSubmitProposal"}, 0, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs([]string{sendTypeURL}, -1, sender).ValidateBasic())
+	require.Error(t, NewMsgPauseMsgs([]string{sendTypeURL}, 0, nilAddr).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgUnpauseMsgs
+// ----------------------------------------------
+
+func TestMsgUnpauseMsgsGetSignBytes(t *testing.T) {
+	msg := NewMsgUnpauseMsgs([]string{sendTypeURL}, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgUnpauseMsgs","value":{"type_urls":["/cosmos.bank.v1beta1.MsgSend"],"unpaused_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgUnpauseMsgsGetSigners(t *testing.T) {
+	msg := NewMsgUnpauseMsgs([]string{sendTypeURL}, sender)
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{sender}, res)
+}
+
+func TestMsgUnpauseMsgsValidation(t *testing.T) {
+	require.NoError(t, NewMsgUnpauseMsgs([]string{sendTypeURL}, sender).ValidateBasic())
+	require.Error(t, NewMsgUnpauseMsgs(nil, sender).ValidateBasic())
+	require.Error(t, NewMsgUnpauseMsgs([]string{sendTypeURL, sendTypeURL}, sender).ValidateBasic())
+	require.Error(t, NewMsgUnpauseMsgs([]string{sendTypeURL}, nilAddr).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgBlockAccount
+// ----------------------------------------------
+
+func TestMsgBlockAccountGetSignBytes(t *testing.T) {
+	msg := NewMsgBlockAccount(testAddr, "compromised", sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgBlockAccount","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","blocked_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf","reason":"compromised"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgBlockAccountGetSigners(t *testing.T) {
+	msg := NewMsgBlockAccount(testAddr, "compromised", sender)
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{sender}, res)
+}
+
+func TestMsgBlockAccountValidation(t *testing.T) {
+	require.NoError(t, NewMsgBlockAccount(testAddr, "compromised", sender).ValidateBasic())
+	require.NoError(t, NewMsgBlockAccount(testAddr, "", sender).ValidateBasic())
+	require.Error(t, NewMsgBlockAccount(testAddr, strings.Repeat("a", MaxDescriptionLength+1), sender).ValidateBasic())
+	require.Error(t, NewMsgBlockAccount(nilAddr, "compromised", sender).ValidateBasic())
+	require.Error(t, NewMsgBlockAccount(testAddr, "compromised", nilAddr).ValidateBasic())
+	require.Error(t, NewMsgBlockAccount(sender, "compromised", sender).ValidateBasic())
+}
+
+// ----------------------------------------------
+// test MsgUnblockAccount
+// ----------------------------------------------
+
+func TestMsgUnblockAccountGetSignBytes(t *testing.T) {
+	msg := NewMsgUnblockAccount(testAddr, sender)
+	res := msg.GetSignBytes()
+	expected := `{"type":"irishub/guardian/MsgUnblockAccount","value":{"address":"iaa1n7rdpqvgf37ktx30a2sv2kkszk3m7ncmakdj4g","unblocked_by":"iaa1pgm8hyk0pvphmlvfjc8wsvk4daluz5tgwp4wlf"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgUnblockAccountGetSigners(t *testing.T) {
+	msg := NewMsgUnblockAccount(testAddr, sender)
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{sender}, res)
+}
+
+func TestMsgUnblockAccountValidation(t *testing.T) {
+	require.NoError(t, NewMsgUnblockAccount(testAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgUnblockAccount(nilAddr, sender).ValidateBasic())
+	require.Error(t, NewMsgUnblockAccount(testAddr, nilAddr).ValidateBasic())
+}
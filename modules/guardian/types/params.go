@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// default paramspace for params keeper
+const (
+	DefaultParamSpace = ModuleName
+)
+
+// Parameter store key
+var (
+	KeyApprovalThreshold = []byte("ApprovalThreshold")
+	KeyProposalLifetime  = []byte("ProposalLifetime")
+
+	KeyMaxSupers            = []byte("MaxSupers")
+	KeyMaxDescriptionLength = []byte("MaxDescriptionLength")
+	KeyOrdinaryCanAddSupers = []byte("OrdinaryCanAddSupers")
+)
+
+// ParamKeyTable for guardian module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams constructs a new Params instance
+func NewParams(
+	approvalThreshold uint32,
+	proposalLifetime time.Duration,
+	maxSupers uint32,
+	maxDescriptionLength uint32,
+	ordinaryCanAddSupers bool,
+) Params {
+	return Params{
+		ApprovalThreshold:    approvalThreshold,
+		ProposalLifetime:     proposalLifetime,
+		MaxSupers:            maxSupers,
+		MaxDescriptionLength: maxDescriptionLength,
+		OrdinaryCanAddSupers: ordinaryCanAddSupers,
+	}
+}
+
+// DefaultParams returns default guardian module parameters
+func DefaultParams() Params {
+	return Params{
+		ApprovalThreshold:    1,
+		ProposalLifetime:     7 * 24 * time.Hour,
+		MaxSupers:            0,
+		MaxDescriptionLength: 70,
+		OrdinaryCanAddSupers: false,
+	}
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyApprovalThreshold, &p.ApprovalThreshold, validateApprovalThreshold),
+		paramtypes.NewParamSetPair(KeyProposalLifetime, &p.ProposalLifetime, validateProposalLifetime),
+		paramtypes.NewParamSetPair(KeyMaxSupers, &p.MaxSupers, validateMaxSupers),
+		paramtypes.NewParamSetPair(KeyMaxDescriptionLength, &p.MaxDescriptionLength, validateMaxDescriptionLength),
+		paramtypes.NewParamSetPair(KeyOrdinaryCanAddSupers, &p.OrdinaryCanAddSupers, validateOrdinaryCanAddSupers),
+	}
+}
+
+// Validate returns err if the Params is invalid
+func (p Params) Validate() error {
+	if err := validateApprovalThreshold(p.ApprovalThreshold); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateProposalLifetime(p.ProposalLifetime); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateMaxSupers(p.MaxSupers); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateMaxDescriptionLength(p.MaxDescriptionLength); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateOrdinaryCanAddSupers(p.OrdinaryCanAddSupers); err != nil {
+		return sdkerrors.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+func validateApprovalThreshold(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("approval threshold must be positive")
+	}
+
+	return nil
+}
+
+func validateProposalLifetime(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("proposal lifetime must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupers(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxDescriptionLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 || v > MaxDescriptionLength {
+		return fmt.Errorf("max description length must be between 1 and %d: %d", MaxDescriptionLength, v)
+	}
+
+	return nil
+}
+
+func validateOrdinaryCanAddSupers(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
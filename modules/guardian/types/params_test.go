@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		params     Params
+	}{
+		{"default", true, DefaultParams()},
+		{"limited supers", true, NewParams(1, time.Hour, 10, 70, true)},
+		{"max description length", true, NewParams(1, time.Hour, 0, MaxDescriptionLength, false)},
+		{"zero approval threshold", false, NewParams(0, time.Hour, 0, 70, false)},
+		{"zero proposal lifetime", false, NewParams(1, 0, 0, 70, false)},
+		{"zero description length", false, NewParams(1, time.Hour, 0, 0, false)},
+		{"description length too large", false, NewParams(1, time.Hour, 0, MaxDescriptionLength+1, false)},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.params.Validate(), tc.name)
+		} else {
+			require.Error(t, tc.params.Validate(), tc.name)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeAddSuper defines the type for an AddSuperProposal
+	ProposalTypeAddSuper = "AddSuper"
+	// ProposalTypeDeleteSuper defines the type for a DeleteSuperProposal
+	ProposalTypeDeleteSuper = "DeleteSuper"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &AddSuperProposal{}
+	_ govtypes.Content = &DeleteSuperProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddSuper)
+	govtypes.RegisterProposalTypeCodec(&AddSuperProposal{}, "irishub/guardian/AddSuperProposal")
+	govtypes.RegisterProposalType(ProposalTypeDeleteSuper)
+	govtypes.RegisterProposalTypeCodec(&DeleteSuperProposal{}, "irishub/guardian/DeleteSuperProposal")
+}
+
+// NewAddSuperProposal creates a new add super proposal
+func NewAddSuperProposal(title, description string, address sdk.AccAddress, superDescription string, accountType AccountType) *AddSuperProposal {
+	return &AddSuperProposal{
+		Title:            title,
+		Description:      description,
+		Address:          address.String(),
+		SuperDescription: superDescription,
+		AccountType:      accountType,
+	}
+}
+
+// GetTitle returns the title of an add super proposal
+func (p *AddSuperProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an add super proposal
+func (p *AddSuperProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an add super proposal
+func (p *AddSuperProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add super proposal
+func (p *AddSuperProposal) ProposalType() string { return ProposalTypeAddSuper }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *AddSuperProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if len(p.SuperDescription) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "super description missing")
+	}
+	if len(p.SuperDescription) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid super description length; got: %d, max: %d", len(p.SuperDescription), MaxDescriptionLength)
+	}
+	if !ValidAccountType(p.AccountType) {
+		return sdkerrors.Wrap(ErrInvalidAccountType, p.AccountType.String())
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p AddSuperProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Super Proposal:
+  Title:             %s
+  Description:       %s
+  Address:           %s
+  Super Description: %s
+  Account Type:      %s
+`, p.Title, p.Description, p.Address, p.SuperDescription, p.AccountType))
+	return b.String()
+}
+
+// NewDeleteSuperProposal creates a new delete super proposal
+func NewDeleteSuperProposal(title, description string, address sdk.AccAddress) *DeleteSuperProposal {
+	return &DeleteSuperProposal{
+		Title:       title,
+		Description: description,
+		Address:     address.String(),
+	}
+}
+
+// GetTitle returns the title of a delete super proposal
+func (p *DeleteSuperProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a delete super proposal
+func (p *DeleteSuperProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a delete super proposal
+func (p *DeleteSuperProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a delete super proposal
+func (p *DeleteSuperProposal) ProposalType() string { return ProposalTypeDeleteSuper }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *DeleteSuperProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (p DeleteSuperProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Delete Super Proposal:
+  Title:       %s
+  Description: %s
+  Address:     %s
+`, p.Title, p.Description, p.Address))
+	return b.String()
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSuperProposalValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		proposal   *AddSuperProposal
+	}{
+		{"pass", true, NewAddSuperProposal("title", "description", testAddr, description, Ordinary)},
+		{"pass genesis", true, NewAddSuperProposal("title", "description", testAddr, description, Genesis)},
+		{"invalid Title", false, NewAddSuperProposal("", "description", testAddr, description, Ordinary)},
+		{"invalid Address", false, NewAddSuperProposal("title", "description", nilAddr, description, Ordinary)},
+		{"invalid SuperDescription", false, NewAddSuperProposal("title", "description", testAddr, nilDescription, Ordinary)},
+		{"invalid AccountType", false, NewAddSuperProposal("title", "description", testAddr, description, AccountType(9))},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteSuperProposalValidateBasic(t *testing.T) {
+	require.NoError(t, NewDeleteSuperProposal("title", "description", testAddr).ValidateBasic())
+	require.Error(t, NewDeleteSuperProposal("title", "", testAddr).ValidateBasic())
+	require.Error(t, NewDeleteSuperProposal("title", "description", nilAddr).ValidateBasic())
+}
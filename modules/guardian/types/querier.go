@@ -0,0 +1,44 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QuerySuperParams defines the params to query a super
+type QuerySuperParams struct {
+	Address sdk.AccAddress
+}
+
+// QueryTrusteeParams defines the params to query a trustee
+type QueryTrusteeParams struct {
+	Address sdk.AccAddress
+}
+
+// QueryBlockedAccountParams defines the params to query a blocked account
+type QueryBlockedAccountParams struct {
+	Address sdk.AccAddress
+}
+
+// QuerySupersParams defines the params to query supers, empty fields are not used for filtering
+type QuerySupersParams struct {
+	AccountType string
+	AddedBy     sdk.AccAddress
+}
+
+// QueryMembershipProposalParams defines the params to query a membership proposal
+type QueryMembershipProposalParams struct {
+	ProposalID uint64
+}
+
+// QueryExpiringSupersParams defines the params to query the supers expiring soon
+type QueryExpiringSupersParams struct {
+	Within time.Duration
+}
+
+// QueryHistoryParams defines the params to query the membership change log, empty fields are not used for filtering
+type QueryHistoryParams struct {
+	Address  sdk.AccAddress
+	Operator sdk.AccAddress
+}
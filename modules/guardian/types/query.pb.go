@@ -10,6 +10,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +19,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,17 +34,111 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// QuerySuperRequest is request type for the Query/Super RPC method
+type QuerySuperRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySuperRequest) Reset()         { *m = QuerySuperRequest{} }
+func (m *QuerySuperRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperRequest) ProtoMessage()    {}
+func (*QuerySuperRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{0}
+}
+func (m *QuerySuperRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuperRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperRequest.Merge(m, src)
+}
+func (m *QuerySuperRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySuperRequest proto.InternalMessageInfo
+
+func (m *QuerySuperRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QuerySuperResponse is response type for the Query/Super RPC method
+type QuerySuperResponse struct {
+	Super Super `protobuf:"bytes,1,opt,name=super,proto3" json:"super"`
+}
+
+func (m *QuerySuperResponse) Reset()         { *m = QuerySuperResponse{} }
+func (m *QuerySuperResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperResponse) ProtoMessage()    {}
+func (*QuerySuperResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{1}
+}
+func (m *QuerySuperResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuperResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperResponse.Merge(m, src)
+}
+func (m *QuerySuperResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySuperResponse proto.InternalMessageInfo
+
+func (m *QuerySuperResponse) GetSuper() Super {
+	if m != nil {
+		return m.Super
+	}
+	return Super{}
+}
+
 // QuerySupersRequest is request type for the Query/Supers RPC method
 type QuerySupersRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// account_type filters the supers by account type (Genesis | Ordinary) if set
+	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty" yaml:"account_type"`
+	// added_by filters the supers by the address which added them if set
+	AddedBy string `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty" yaml:"added_by"`
 }
 
 func (m *QuerySupersRequest) Reset()         { *m = QuerySupersRequest{} }
 func (m *QuerySupersRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySupersRequest) ProtoMessage()    {}
 func (*QuerySupersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20cf24f8e5be2110, []int{0}
+	return fileDescriptor_20cf24f8e5be2110, []int{2}
 }
 func (m *QuerySupersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,6 +174,20 @@ func (m *QuerySupersRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QuerySupersRequest) GetAccountType() string {
+	if m != nil {
+		return m.AccountType
+	}
+	return ""
+}
+
+func (m *QuerySupersRequest) GetAddedBy() string {
+	if m != nil {
+		return m.AddedBy
+	}
+	return ""
+}
+
 // QuerySupersResponse is response type for the Query/Supers RPC method
 type QuerySupersResponse struct {
 	Supers     []Super             `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
@@ -86,7 +198,7 @@ func (m *QuerySupersResponse) Reset()         { *m = QuerySupersResponse{} }
 func (m *QuerySupersResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupersResponse) ProtoMessage()    {}
 func (*QuerySupersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_20cf24f8e5be2110, []int{1}
+	return fileDescriptor_20cf24f8e5be2110, []int{3}
 }
 func (m *QuerySupersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,254 +241,5085 @@ func (m *QuerySupersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySupersRequest)(nil), "irishub.guardian.QuerySupersRequest")
-	proto.RegisterType((*QuerySupersResponse)(nil), "irishub.guardian.QuerySupersResponse")
+// QuerySuperDescendantsRequest is request type for the Query/SuperDescendants RPC method
+type QuerySuperDescendantsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() { proto.RegisterFile("guardian/query.proto", fileDescriptor_20cf24f8e5be2110) }
-
-var fileDescriptor_20cf24f8e5be2110 = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x4f, 0x02, 0x31,
-	0x14, 0xc6, 0xaf, 0xa8, 0x0c, 0x65, 0x31, 0x95, 0x04, 0xbc, 0xe8, 0x49, 0x88, 0x26, 0x4c, 0xd7,
-	0x88, 0x71, 0xd0, 0x91, 0xd5, 0x41, 0xc5, 0xcd, 0xad, 0x40, 0x53, 0x9b, 0x40, 0xdf, 0x71, 0x6d,
-	0x63, 0xd8, 0x8c, 0x93, 0xa3, 0x89, 0xff, 0x14, 0x23, 0x89, 0x8b, 0x93, 0x31, 0xe0, 0x1f, 0x62,
-	0x68, 0x8f, 0x03, 0xc4, 0xc4, 0xad, 0xe9, 0xf7, 0xbd, 0xef, 0xfb, 0xbd, 0x3c, 0x5c, 0x16, 0x96,
-	0xa5, 0x3d, 0xc9, 0x14, 0x1d, 0x5a, 0x9e, 0x8e, 0xe2, 0x24, 0x05, 0x03, 0x64, 0x57, 0xa6, 0x52,
-	0x3f, 0xd8, 0x4e, 0xbc, 0x50, 0xc3, 0xb2, 0x00, 0x01, 0x4e, 0xa4, 0xf3, 0x97, 0xf7, 0x85, 0x95,
-	0x7c, 0x7a, 0xf1, 0xc8, 0x84, 0x03, 0x01, 0x20, 0xfa, 0x9c, 0xb2, 0x44, 0x52, 0xa6, 0x14, 0x18,
-	0x66, 0x24, 0x28, 0x9d, 0xa9, 0x87, 0x5d, 0xd0, 0x03, 0xd0, 0xbe, 0x92, 0x26, 0x4c, 0x48, 0xe5,
-	0x74, 0x2f, 0xd7, 0xaf, 0x31, 0xb9, 0x9d, 0x2b, 0x77, 0x36, 0xe1, 0xa9, 0x6e, 0xf3, 0xa1, 0xe5,
-	0xda, 0x90, 0x0b, 0x8c, 0x97, 0xce, 0x2a, 0xaa, 0xa1, 0x46, 0xa9, 0xb9, 0x1f, 0xfb, 0xa4, 0xd8,
-	0xc3, 0xdf, 0x30, 0xc1, 0x33, 0x7b, 0x7b, 0xc5, 0x5c, 0x7f, 0x41, 0x78, 0x6f, 0x2d, 0x51, 0x27,
-	0xa0, 0x34, 0x27, 0xe7, 0xb8, 0xa8, 0xdd, 0x4f, 0x15, 0xd5, 0xb6, 0x1a, 0xa5, 0x66, 0x25, 0xfe,
-	0xbd, 0x77, 0xec, 0x26, 0x5a, 0xdb, 0xe3, 0xcf, 0xa3, 0xa0, 0x9d, 0x99, 0xc9, 0xe5, 0x1a, 0x49,
-	0xc1, 0x91, 0x84, 0x7f, 0x91, 0xf8, 0x9a, 0x55, 0x94, 0xe6, 0x13, 0xc2, 0x3b, 0x0e, 0x85, 0x3c,
-	0xe2, 0xa2, 0xc7, 0x21, 0xc7, 0x9b, 0xb5, 0x9b, 0xfb, 0x87, 0x27, 0xff, 0xb8, 0x7c, 0x59, 0xbd,
-	0xf6, 0xfc, 0xfe, 0xfd, 0x56, 0x08, 0x49, 0x95, 0x66, 0xf6, 0xfc, 0x34, 0xd4, 0xe3, 0xb7, 0xae,
-	0xc6, 0xd3, 0x08, 0x4d, 0xa6, 0x11, 0xfa, 0x9a, 0x46, 0xe8, 0x75, 0x16, 0x05, 0x93, 0x59, 0x14,
-	0x7c, 0xcc, 0xa2, 0xe0, 0xfe, 0x54, 0x48, 0x33, 0x2f, 0xe8, 0xc2, 0xc0, 0x4d, 0x2b, 0x6e, 0xf2,
-	0x94, 0x01, 0xf4, 0x6c, 0x9f, 0xeb, 0x65, 0x9a, 0x19, 0x25, 0x5c, 0x77, 0x8a, 0xee, 0x64, 0x67,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x03, 0x7a, 0x40, 0x34, 0x48, 0x02, 0x00, 0x00,
+func (m *QuerySuperDescendantsRequest) Reset()         { *m = QuerySuperDescendantsRequest{} }
+func (m *QuerySuperDescendantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperDescendantsRequest) ProtoMessage()    {}
+func (*QuerySuperDescendantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{4}
+}
+func (m *QuerySuperDescendantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperDescendantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperDescendantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuperDescendantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperDescendantsRequest.Merge(m, src)
+}
+func (m *QuerySuperDescendantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperDescendantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperDescendantsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySuperDescendantsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Supers returns all Supers
-	Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error)
+func (m *QuerySuperDescendantsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QuerySuperDescendantsResponse is response type for the Query/SuperDescendants RPC method
+type QuerySuperDescendantsResponse struct {
+	Supers []Super `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySuperDescendantsResponse) Reset()         { *m = QuerySuperDescendantsResponse{} }
+func (m *QuerySuperDescendantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySuperDescendantsResponse) ProtoMessage()    {}
+func (*QuerySuperDescendantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{5}
+}
+func (m *QuerySuperDescendantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySuperDescendantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySuperDescendantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySuperDescendantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySuperDescendantsResponse.Merge(m, src)
+}
+func (m *QuerySuperDescendantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySuperDescendantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySuperDescendantsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error) {
-	out := new(QuerySupersResponse)
-	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Supers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySuperDescendantsResponse proto.InternalMessageInfo
+
+func (m *QuerySuperDescendantsResponse) GetSupers() []Super {
+	if m != nil {
+		return m.Supers
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Supers returns all Supers
-	Supers(context.Context, *QuerySupersRequest) (*QuerySupersResponse, error)
+// QueryExpiringSupersRequest is request type for the Query/ExpiringSupers RPC method
+type QueryExpiringSupersRequest struct {
+	Within time.Duration `protobuf:"bytes,1,opt,name=within,proto3,stdduration" json:"within"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryExpiringSupersRequest) Reset()         { *m = QueryExpiringSupersRequest{} }
+func (m *QueryExpiringSupersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringSupersRequest) ProtoMessage()    {}
+func (*QueryExpiringSupersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{6}
+}
+func (m *QueryExpiringSupersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringSupersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringSupersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringSupersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringSupersRequest.Merge(m, src)
+}
+func (m *QueryExpiringSupersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringSupersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringSupersRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Supers(ctx context.Context, req *QuerySupersRequest) (*QuerySupersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Supers not implemented")
+var xxx_messageInfo_QueryExpiringSupersRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringSupersRequest) GetWithin() time.Duration {
+	if m != nil {
+		return m.Within
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryExpiringSupersResponse is response type for the Query/ExpiringSupers RPC method
+type QueryExpiringSupersResponse struct {
+	Supers []Super `protobuf:"bytes,1,rep,name=supers,proto3" json:"supers"`
 }
 
-func _Query_Supers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Supers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.guardian.Query/Supers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Supers(ctx, req.(*QuerySupersRequest))
+func (m *QueryExpiringSupersResponse) Reset()         { *m = QueryExpiringSupersResponse{} }
+func (m *QueryExpiringSupersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringSupersResponse) ProtoMessage()    {}
+func (*QueryExpiringSupersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{7}
+}
+func (m *QueryExpiringSupersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringSupersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringSupersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "irishub.guardian.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Supers",
-			Handler:    _Query_Supers_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "guardian/query.proto",
+func (m *QueryExpiringSupersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringSupersResponse.Merge(m, src)
+}
+func (m *QueryExpiringSupersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringSupersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringSupersResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySupersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExpiringSupersResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringSupersResponse) GetSupers() []Super {
+	if m != nil {
+		return m.Supers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySupersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTrusteeRequest is request type for the Query/Trustee RPC method
+type QueryTrusteeRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QuerySupersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTrusteeRequest) Reset()         { *m = QueryTrusteeRequest{} }
+func (m *QueryTrusteeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrusteeRequest) ProtoMessage()    {}
+func (*QueryTrusteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{8}
+}
+func (m *QueryTrusteeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrusteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrusteeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTrusteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrusteeRequest.Merge(m, src)
+}
+func (m *QueryTrusteeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrusteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrusteeRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySupersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTrusteeRequest proto.InternalMessageInfo
+
+func (m *QueryTrusteeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySupersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTrusteeResponse is response type for the Query/Trustee RPC method
+type QueryTrusteeResponse struct {
+	Trustee Trustee `protobuf:"bytes,1,opt,name=trustee,proto3" json:"trustee"`
 }
 
-func (m *QuerySupersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTrusteeResponse) Reset()         { *m = QueryTrusteeResponse{} }
+func (m *QueryTrusteeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrusteeResponse) ProtoMessage()    {}
+func (*QueryTrusteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{9}
+}
+func (m *QueryTrusteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrusteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrusteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Supers) > 0 {
-		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Supers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryTrusteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrusteeResponse.Merge(m, src)
+}
+func (m *QueryTrusteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrusteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrusteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrusteeResponse proto.InternalMessageInfo
+
+func (m *QueryTrusteeResponse) GetTrustee() Trustee {
+	if m != nil {
+		return m.Trustee
+	}
+	return Trustee{}
+}
+
+// QueryTrusteesRequest is request type for the Query/Trustees RPC method
+type QueryTrusteesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTrusteesRequest) Reset()         { *m = QueryTrusteesRequest{} }
+func (m *QueryTrusteesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTrusteesRequest) ProtoMessage()    {}
+func (*QueryTrusteesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{10}
+}
+func (m *QueryTrusteesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrusteesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrusteesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTrusteesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrusteesRequest.Merge(m, src)
+}
+func (m *QueryTrusteesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrusteesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrusteesRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryTrusteesRequest proto.InternalMessageInfo
+
+func (m *QueryTrusteesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QuerySupersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryTrusteesResponse is response type for the Query/Trustees RPC method
+type QueryTrusteesResponse struct {
+	Trustees   []Trustee           `protobuf:"bytes,1,rep,name=trustees,proto3" json:"trustees"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTrusteesResponse) Reset()         { *m = QueryTrusteesResponse{} }
+func (m *QueryTrusteesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTrusteesResponse) ProtoMessage()    {}
+func (*QueryTrusteesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{11}
+}
+func (m *QueryTrusteesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTrusteesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTrusteesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryTrusteesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTrusteesResponse.Merge(m, src)
+}
+func (m *QueryTrusteesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTrusteesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTrusteesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTrusteesResponse proto.InternalMessageInfo
+
+func (m *QueryTrusteesResponse) GetTrustees() []Trustee {
+	if m != nil {
+		return m.Trustees
 	}
-	return n
+	return nil
 }
 
-func (m *QuerySupersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTrusteesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Supers) > 0 {
-		for _, e := range m.Supers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryPausedMsgsRequest is request type for the Query/PausedMsgs RPC method
+type QueryPausedMsgsRequest struct {
+}
+
+func (m *QueryPausedMsgsRequest) Reset()         { *m = QueryPausedMsgsRequest{} }
+func (m *QueryPausedMsgsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedMsgsRequest) ProtoMessage()    {}
+func (*QueryPausedMsgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{12}
+}
+func (m *QueryPausedMsgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedMsgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedMsgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedMsgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedMsgsRequest.Merge(m, src)
+}
+func (m *QueryPausedMsgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedMsgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedMsgsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedMsgsRequest proto.InternalMessageInfo
+
+// QueryPausedMsgsResponse is response type for the Query/PausedMsgs RPC method
+type QueryPausedMsgsResponse struct {
+	PausedMsgs []PausedMsg `protobuf:"bytes,1,rep,name=paused_msgs,json=pausedMsgs,proto3" json:"paused_msgs"`
+}
+
+func (m *QueryPausedMsgsResponse) Reset()         { *m = QueryPausedMsgsResponse{} }
+func (m *QueryPausedMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedMsgsResponse) ProtoMessage()    {}
+func (*QueryPausedMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{13}
+}
+func (m *QueryPausedMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedMsgsResponse.Merge(m, src)
+}
+func (m *QueryPausedMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedMsgsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedMsgsResponse) GetPausedMsgs() []PausedMsg {
+	if m != nil {
+		return m.PausedMsgs
+	}
+	return nil
+}
+
+// QueryBlockedAccountRequest is request type for the Query/BlockedAccount RPC method
+type QueryBlockedAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBlockedAccountRequest) Reset()         { *m = QueryBlockedAccountRequest{} }
+func (m *QueryBlockedAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAccountRequest) ProtoMessage()    {}
+func (*QueryBlockedAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{14}
+}
+func (m *QueryBlockedAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAccountRequest.Merge(m, src)
+}
+func (m *QueryBlockedAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAccountRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryBlockedAccountResponse is response type for the Query/BlockedAccount RPC method
+type QueryBlockedAccountResponse struct {
+	BlockedAccount BlockedAccount `protobuf:"bytes,1,opt,name=blocked_account,json=blockedAccount,proto3" json:"blocked_account" yaml:"blocked_account"`
+}
+
+func (m *QueryBlockedAccountResponse) Reset()         { *m = QueryBlockedAccountResponse{} }
+func (m *QueryBlockedAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAccountResponse) ProtoMessage()    {}
+func (*QueryBlockedAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{15}
+}
+func (m *QueryBlockedAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAccountResponse.Merge(m, src)
+}
+func (m *QueryBlockedAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAccountResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAccountResponse) GetBlockedAccount() BlockedAccount {
+	if m != nil {
+		return m.BlockedAccount
+	}
+	return BlockedAccount{}
+}
+
+// QueryBlockedAccountsRequest is request type for the Query/BlockedAccounts RPC method
+type QueryBlockedAccountsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAccountsRequest) Reset()         { *m = QueryBlockedAccountsRequest{} }
+func (m *QueryBlockedAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAccountsRequest) ProtoMessage()    {}
+func (*QueryBlockedAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{16}
+}
+func (m *QueryBlockedAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAccountsRequest.Merge(m, src)
+}
+func (m *QueryBlockedAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryBlockedAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockedAccountsResponse is response type for the Query/BlockedAccounts RPC method
+type QueryBlockedAccountsResponse struct {
+	BlockedAccounts []BlockedAccount    `protobuf:"bytes,1,rep,name=blocked_accounts,json=blockedAccounts,proto3" json:"blocked_accounts" yaml:"blocked_accounts"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedAccountsResponse) Reset()         { *m = QueryBlockedAccountsResponse{} }
+func (m *QueryBlockedAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAccountsResponse) ProtoMessage()    {}
+func (*QueryBlockedAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{17}
+}
+func (m *QueryBlockedAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockedAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAccountsResponse.Merge(m, src)
+}
+func (m *QueryBlockedAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedAccountsResponse) GetBlockedAccounts() []BlockedAccount {
+	if m != nil {
+		return m.BlockedAccounts
+	}
+	return nil
+}
+
+func (m *QueryBlockedAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHistoryRequest is request type for the Query/History RPC method
+type QueryHistoryRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// address filters the entries by the changed super if set
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// operator filters the entries by the address which made the change if set
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *QueryHistoryRequest) Reset()         { *m = QueryHistoryRequest{} }
+func (m *QueryHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryRequest) ProtoMessage()    {}
+func (*QueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{18}
+}
+func (m *QueryHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryRequest.Merge(m, src)
+}
+func (m *QueryHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryHistoryRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+// QueryHistoryResponse is response type for the Query/History RPC method
+type QueryHistoryResponse struct {
+	History    []HistoryEntry      `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHistoryResponse) Reset()         { *m = QueryHistoryResponse{} }
+func (m *QueryHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoryResponse) ProtoMessage()    {}
+func (*QueryHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{19}
+}
+func (m *QueryHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoryResponse.Merge(m, src)
+}
+func (m *QueryHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryHistoryResponse) GetHistory() []HistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMembershipProposalsRequest is request type for the Query/MembershipProposals RPC method
+type QueryMembershipProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMembershipProposalsRequest) Reset()         { *m = QueryMembershipProposalsRequest{} }
+func (m *QueryMembershipProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMembershipProposalsRequest) ProtoMessage()    {}
+func (*QueryMembershipProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{20}
+}
+func (m *QueryMembershipProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMembershipProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMembershipProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMembershipProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMembershipProposalsRequest.Merge(m, src)
+}
+func (m *QueryMembershipProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMembershipProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMembershipProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMembershipProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryMembershipProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMembershipProposalsResponse is response type for the Query/MembershipProposals RPC method
+type QueryMembershipProposalsResponse struct {
+	Proposals  []MembershipProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMembershipProposalsResponse) Reset()         { *m = QueryMembershipProposalsResponse{} }
+func (m *QueryMembershipProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMembershipProposalsResponse) ProtoMessage()    {}
+func (*QueryMembershipProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{21}
+}
+func (m *QueryMembershipProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMembershipProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMembershipProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMembershipProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMembershipProposalsResponse.Merge(m, src)
+}
+func (m *QueryMembershipProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMembershipProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMembershipProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMembershipProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryMembershipProposalsResponse) GetProposals() []MembershipProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryMembershipProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMembershipProposalRequest is request type for the Query/MembershipProposal RPC method
+type QueryMembershipProposalRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryMembershipProposalRequest) Reset()         { *m = QueryMembershipProposalRequest{} }
+func (m *QueryMembershipProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMembershipProposalRequest) ProtoMessage()    {}
+func (*QueryMembershipProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{22}
+}
+func (m *QueryMembershipProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMembershipProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMembershipProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMembershipProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMembershipProposalRequest.Merge(m, src)
+}
+func (m *QueryMembershipProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMembershipProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMembershipProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMembershipProposalRequest proto.InternalMessageInfo
+
+func (m *QueryMembershipProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryMembershipProposalResponse is response type for the Query/MembershipProposal RPC method
+type QueryMembershipProposalResponse struct {
+	Proposal MembershipProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryMembershipProposalResponse) Reset()         { *m = QueryMembershipProposalResponse{} }
+func (m *QueryMembershipProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMembershipProposalResponse) ProtoMessage()    {}
+func (*QueryMembershipProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{23}
+}
+func (m *QueryMembershipProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMembershipProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMembershipProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMembershipProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMembershipProposalResponse.Merge(m, src)
+}
+func (m *QueryMembershipProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMembershipProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMembershipProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMembershipProposalResponse proto.InternalMessageInfo
+
+func (m *QueryMembershipProposalResponse) GetProposal() MembershipProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return MembershipProposal{}
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{24}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20cf24f8e5be2110, []int{25}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QuerySuperRequest)(nil), "irishub.guardian.QuerySuperRequest")
+	proto.RegisterType((*QuerySuperResponse)(nil), "irishub.guardian.QuerySuperResponse")
+	proto.RegisterType((*QuerySupersRequest)(nil), "irishub.guardian.QuerySupersRequest")
+	proto.RegisterType((*QuerySupersResponse)(nil), "irishub.guardian.QuerySupersResponse")
+	proto.RegisterType((*QuerySuperDescendantsRequest)(nil), "irishub.guardian.QuerySuperDescendantsRequest")
+	proto.RegisterType((*QuerySuperDescendantsResponse)(nil), "irishub.guardian.QuerySuperDescendantsResponse")
+	proto.RegisterType((*QueryExpiringSupersRequest)(nil), "irishub.guardian.QueryExpiringSupersRequest")
+	proto.RegisterType((*QueryExpiringSupersResponse)(nil), "irishub.guardian.QueryExpiringSupersResponse")
+	proto.RegisterType((*QueryTrusteeRequest)(nil), "irishub.guardian.QueryTrusteeRequest")
+	proto.RegisterType((*QueryTrusteeResponse)(nil), "irishub.guardian.QueryTrusteeResponse")
+	proto.RegisterType((*QueryTrusteesRequest)(nil), "irishub.guardian.QueryTrusteesRequest")
+	proto.RegisterType((*QueryTrusteesResponse)(nil), "irishub.guardian.QueryTrusteesResponse")
+	proto.RegisterType((*QueryPausedMsgsRequest)(nil), "irishub.guardian.QueryPausedMsgsRequest")
+	proto.RegisterType((*QueryPausedMsgsResponse)(nil), "irishub.guardian.QueryPausedMsgsResponse")
+	proto.RegisterType((*QueryBlockedAccountRequest)(nil), "irishub.guardian.QueryBlockedAccountRequest")
+	proto.RegisterType((*QueryBlockedAccountResponse)(nil), "irishub.guardian.QueryBlockedAccountResponse")
+	proto.RegisterType((*QueryBlockedAccountsRequest)(nil), "irishub.guardian.QueryBlockedAccountsRequest")
+	proto.RegisterType((*QueryBlockedAccountsResponse)(nil), "irishub.guardian.QueryBlockedAccountsResponse")
+	proto.RegisterType((*QueryHistoryRequest)(nil), "irishub.guardian.QueryHistoryRequest")
+	proto.RegisterType((*QueryHistoryResponse)(nil), "irishub.guardian.QueryHistoryResponse")
+	proto.RegisterType((*QueryMembershipProposalsRequest)(nil), "irishub.guardian.QueryMembershipProposalsRequest")
+	proto.RegisterType((*QueryMembershipProposalsResponse)(nil), "irishub.guardian.QueryMembershipProposalsResponse")
+	proto.RegisterType((*QueryMembershipProposalRequest)(nil), "irishub.guardian.QueryMembershipProposalRequest")
+	proto.RegisterType((*QueryMembershipProposalResponse)(nil), "irishub.guardian.QueryMembershipProposalResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "irishub.guardian.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "irishub.guardian.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("guardian/query.proto", fileDescriptor_20cf24f8e5be2110) }
+
+var fileDescriptor_20cf24f8e5be2110 = []byte{
+	// 1255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xc0, 0xeb, 0x6e, 0x6b, 0xb3, 0x53, 0xd4, 0x8e, 0xdb, 0xb2, 0x66, 0x6e, 0x9b, 0x74, 0xee,
+	0x1f, 0x56, 0x68, 0xed, 0xb5, 0x83, 0x8a, 0x75, 0x12, 0xd2, 0xa2, 0x0d, 0x6d, 0x42, 0x95, 0xba,
+	0x50, 0x21, 0x40, 0xa0, 0xc8, 0x89, 0x2f, 0xa9, 0x45, 0x62, 0x7b, 0xbe, 0x8e, 0x46, 0x54, 0x01,
+	0x12, 0x0f, 0x53, 0x1f, 0x27, 0x78, 0xd9, 0x03, 0x2f, 0x88, 0x17, 0x24, 0xf8, 0x06, 0x7c, 0x81,
+	0x3e, 0x4e, 0xe2, 0x85, 0xa7, 0x82, 0x5a, 0x1e, 0x79, 0xea, 0x27, 0x40, 0xb9, 0x3e, 0xd7, 0xb1,
+	0x63, 0xbb, 0x4e, 0x95, 0xbe, 0xd5, 0xf7, 0xfc, 0xfb, 0x9d, 0x73, 0x4f, 0xee, 0x39, 0x1b, 0x4c,
+	0xd5, 0x5b, 0xba, 0x6b, 0x98, 0xba, 0xa5, 0x3d, 0x6d, 0x51, 0xb7, 0xad, 0x3a, 0xae, 0xed, 0xd9,
+	0xe4, 0x9a, 0xe9, 0x9a, 0x6c, 0xaf, 0x55, 0x55, 0x85, 0x54, 0x9e, 0xaa, 0xdb, 0x75, 0x9b, 0x0b,
+	0xb5, 0xce, 0x5f, 0xbe, 0x9e, 0x3c, 0x1d, 0x58, 0x8b, 0x3f, 0x50, 0x30, 0x5b, 0xb7, 0xed, 0x7a,
+	0x83, 0x6a, 0xba, 0x63, 0x6a, 0xba, 0x65, 0xd9, 0x9e, 0xee, 0x99, 0xb6, 0xc5, 0x50, 0x3a, 0x57,
+	0xb3, 0x59, 0xd3, 0x66, 0x7e, 0x48, 0xcd, 0xd1, 0xeb, 0xa6, 0xc5, 0xe5, 0x28, 0x2e, 0xa0, 0x31,
+	0xff, 0xaa, 0xb6, 0xbe, 0xd4, 0x8c, 0x96, 0x1b, 0x92, 0x2b, 0x6b, 0xf0, 0xfa, 0x93, 0x8e, 0xe5,
+	0x47, 0x2d, 0x87, 0xba, 0x65, 0xfa, 0xb4, 0x45, 0x99, 0x47, 0xf2, 0x30, 0xaa, 0x1b, 0x86, 0x4b,
+	0x19, 0xcb, 0x4b, 0xf3, 0xd2, 0xad, 0xab, 0x65, 0xf1, 0xa9, 0x3c, 0x06, 0x12, 0x56, 0x67, 0x8e,
+	0x6d, 0x31, 0x4a, 0xee, 0xc0, 0x15, 0xd6, 0x39, 0xe0, 0xda, 0x63, 0x1b, 0xd3, 0x6a, 0x6f, 0xca,
+	0x2a, 0xd7, 0x2f, 0x5d, 0x3e, 0x3c, 0x2a, 0x0e, 0x95, 0x7d, 0x5d, 0xe5, 0x0f, 0x29, 0xec, 0x8b,
+	0x89, 0xd8, 0x77, 0x01, 0xba, 0x49, 0xa0, 0xc3, 0x1b, 0xaa, 0x9f, 0xa4, 0xea, 0xd7, 0x75, 0x47,
+	0xaf, 0x53, 0x54, 0x2f, 0x87, 0x94, 0xc9, 0x16, 0xbc, 0xa6, 0xd7, 0x6a, 0x76, 0xcb, 0xf2, 0x2a,
+	0x5e, 0xdb, 0xa1, 0xf9, 0xe1, 0x0e, 0x7b, 0x69, 0xfa, 0xf4, 0xa8, 0x38, 0xd9, 0xd6, 0x9b, 0x8d,
+	0x2d, 0x25, 0x2c, 0x55, 0xca, 0x63, 0xf8, 0xb9, 0xdb, 0x76, 0x28, 0x51, 0x21, 0xa7, 0x1b, 0x06,
+	0x35, 0x2a, 0xd5, 0x76, 0xfe, 0x12, 0xb7, 0x9b, 0x3c, 0x3d, 0x2a, 0x4e, 0xa0, 0x1d, 0x4a, 0x14,
+	0x5e, 0x08, 0x6a, 0x94, 0xda, 0xca, 0x81, 0x04, 0x93, 0x11, 0x7a, 0x2c, 0xc5, 0xbb, 0x30, 0xc2,
+	0xd3, 0xeb, 0x54, 0xee, 0x52, 0x76, 0x2d, 0x50, 0x99, 0x6c, 0x45, 0xb2, 0x1e, 0xe6, 0x59, 0xcb,
+	0x49, 0x59, 0xfb, 0x61, 0xc2, 0x69, 0x2b, 0xef, 0xc1, 0x6c, 0x97, 0xe4, 0x01, 0x65, 0x35, 0x6a,
+	0x19, 0xba, 0xe5, 0xb1, 0xec, 0xdb, 0xfc, 0x18, 0xe6, 0x52, 0x2c, 0x07, 0xca, 0x46, 0xf9, 0x14,
+	0x64, 0xee, 0xf7, 0xe1, 0xd7, 0x8e, 0xe9, 0x9a, 0x56, 0x3d, 0x7a, 0xc3, 0xf7, 0x60, 0xe4, 0x99,
+	0xe9, 0xed, 0x99, 0xdd, 0xdb, 0xf5, 0x7b, 0x54, 0x15, 0x3d, 0xaa, 0x3e, 0xc0, 0x1e, 0x2d, 0xe5,
+	0x3a, 0x6e, 0x5f, 0xfe, 0x5d, 0x94, 0xca, 0x68, 0xa2, 0xec, 0xc2, 0x4c, 0xa2, 0xeb, 0xc1, 0x80,
+	0x35, 0xbc, 0xcc, 0x5d, 0xb7, 0xc5, 0x3c, 0x4a, 0xb3, 0x2b, 0xf7, 0x04, 0xa6, 0xa2, 0x06, 0x18,
+	0xff, 0x2e, 0x8c, 0x7a, 0xfe, 0x51, 0x90, 0x5c, 0x0c, 0x00, 0x6d, 0x10, 0x41, 0xe8, 0xf7, 0xba,
+	0xbc, 0x80, 0x1f, 0x84, 0xf2, 0x42, 0x82, 0x37, 0x7a, 0x7c, 0x22, 0xe7, 0x3d, 0xc8, 0x61, 0x5c,
+	0x51, 0xa9, 0x4c, 0xd0, 0xc0, 0x60, 0xa0, 0x66, 0xcd, 0xc3, 0x75, 0x4e, 0xb4, 0xa3, 0xb7, 0x18,
+	0x35, 0xb6, 0x59, 0x5d, 0xe4, 0xa9, 0x7c, 0x01, 0xd3, 0x31, 0x09, 0xd2, 0x96, 0x60, 0xcc, 0xe1,
+	0xa7, 0x95, 0x26, 0xab, 0x0b, 0xe0, 0x99, 0x38, 0x70, 0x60, 0x8a, 0xc8, 0xe0, 0x04, 0xbe, 0x94,
+	0x4d, 0xec, 0xc9, 0x52, 0xc3, 0xae, 0x7d, 0x45, 0x8d, 0xfb, 0xfe, 0x6f, 0x3f, 0xfb, 0xa6, 0x0f,
+	0x24, 0x98, 0x49, 0x34, 0x44, 0x36, 0x13, 0x26, 0xaa, 0xbe, 0xa4, 0x82, 0xef, 0x09, 0xde, 0xd1,
+	0x7c, 0x9c, 0x2f, 0xea, 0xa2, 0x54, 0xe8, 0x40, 0x9e, 0x1e, 0x15, 0xaf, 0xfb, 0xaf, 0x4c, 0x8f,
+	0x1b, 0xa5, 0x3c, 0x5e, 0x8d, 0xe8, 0x2b, 0x9f, 0x24, 0x92, 0x5c, 0x44, 0xa3, 0x1c, 0x4a, 0x30,
+	0x9b, 0xec, 0x1a, 0xb3, 0x6c, 0xc0, 0xb5, 0x1e, 0x3c, 0x71, 0x0d, 0xd9, 0x69, 0x16, 0x31, 0xcd,
+	0xe9, 0xc4, 0x34, 0x99, 0x52, 0x9e, 0x88, 0xe6, 0x39, 0x58, 0x83, 0x3d, 0x17, 0x0f, 0xf3, 0x23,
+	0x93, 0x79, 0xb6, 0xdb, 0xbe, 0x80, 0xb9, 0x12, 0x6a, 0x8e, 0xe1, 0x48, 0x73, 0x10, 0x19, 0x72,
+	0xb6, 0x43, 0x5d, 0xdd, 0xb3, 0x5d, 0x7f, 0x6a, 0x94, 0x83, 0x6f, 0xe5, 0x07, 0x09, 0xa6, 0xa2,
+	0x20, 0x58, 0xcb, 0xf7, 0x61, 0x74, 0xcf, 0x3f, 0xc2, 0x12, 0x16, 0xe2, 0x25, 0x44, 0x9b, 0x87,
+	0x96, 0xe7, 0xb6, 0xc5, 0x43, 0x81, 0x46, 0x03, 0x55, 0xe7, 0x73, 0x28, 0x72, 0xa6, 0x6d, 0xda,
+	0xac, 0x52, 0x97, 0xed, 0x99, 0xce, 0x8e, 0x6b, 0x3b, 0x36, 0xd3, 0x1b, 0x17, 0xd1, 0x46, 0xbf,
+	0x4a, 0x30, 0x9f, 0xee, 0x1e, 0xd3, 0x7f, 0x04, 0x57, 0x1d, 0x71, 0x88, 0x05, 0x58, 0x8c, 0x17,
+	0x20, 0xee, 0x01, 0xcb, 0xd0, 0x35, 0x1e, 0xa8, 0x10, 0xf7, 0xa1, 0x90, 0x42, 0x2a, 0xea, 0x50,
+	0x84, 0x31, 0x11, 0xaa, 0x62, 0x1a, 0xbc, 0x10, 0x97, 0xcb, 0x20, 0x8e, 0x1e, 0x1b, 0x8a, 0x99,
+	0x5a, 0xcb, 0x20, 0xd7, 0x0f, 0x20, 0x27, 0x0c, 0xb0, 0x92, 0xe7, 0x49, 0x35, 0xb0, 0x55, 0xa6,
+	0x70, 0x55, 0xda, 0xd1, 0x5d, 0xbd, 0x19, 0xbc, 0x98, 0xdb, 0x30, 0x19, 0x39, 0xc5, 0xa0, 0x9b,
+	0x30, 0xe2, 0xf0, 0x13, 0x0c, 0x99, 0x4f, 0x7a, 0x28, 0x3b, 0x72, 0x31, 0x04, 0x7d, 0xed, 0x8d,
+	0xff, 0xc6, 0xe1, 0x0a, 0xf7, 0x47, 0xbe, 0x85, 0x2b, 0x7c, 0x4a, 0x92, 0x85, 0xb8, 0x69, 0x6c,
+	0x5b, 0x94, 0x17, 0xcf, 0x56, 0xf2, 0xa9, 0x94, 0xb7, 0xbe, 0xff, 0xf3, 0xdf, 0x1f, 0x87, 0x17,
+	0x89, 0xa2, 0xa1, 0x76, 0xb0, 0xe6, 0x6a, 0xfe, 0x10, 0xd6, 0xf6, 0xf1, 0x57, 0xf5, 0x0d, 0x79,
+	0x06, 0x23, 0xdc, 0x98, 0x91, 0x33, 0x7d, 0x8b, 0x42, 0xc8, 0x4b, 0x19, 0x5a, 0x88, 0x30, 0xcf,
+	0x11, 0x64, 0x92, 0x4f, 0x43, 0x20, 0xbf, 0x49, 0x70, 0xad, 0x77, 0x19, 0x22, 0xea, 0x59, 0xde,
+	0xe3, 0xfb, 0x96, 0xac, 0xf5, 0xad, 0x8f, 0x5c, 0x9b, 0x9c, 0xeb, 0x36, 0x51, 0xb3, 0x4b, 0xa3,
+	0x19, 0x21, 0xb0, 0x97, 0x12, 0x8c, 0x47, 0xf7, 0x20, 0xb2, 0x9a, 0x12, 0x3b, 0x71, 0x13, 0x93,
+	0xd7, 0xfa, 0xd4, 0x46, 0xce, 0x15, 0xce, 0xb9, 0x40, 0x6e, 0xc6, 0x39, 0x29, 0x5a, 0x54, 0xb0,
+	0x90, 0xcf, 0x25, 0x18, 0xc5, 0xf5, 0x81, 0xa4, 0xdd, 0x4e, 0x74, 0xd9, 0x92, 0x97, 0xb3, 0xd4,
+	0x90, 0x62, 0x95, 0x53, 0x2c, 0x93, 0xc5, 0x38, 0x85, 0xd8, 0x50, 0x42, 0xad, 0xf4, 0x1d, 0xe4,
+	0xd0, 0x01, 0x23, 0x19, 0x11, 0x82, 0xb2, 0xbc, 0x99, 0xa9, 0x87, 0x28, 0x0a, 0x47, 0x99, 0x25,
+	0x72, 0x3a, 0x0a, 0x39, 0x90, 0x00, 0xba, 0x2b, 0x0d, 0xb9, 0x95, 0xe2, 0x3b, 0xb6, 0x0f, 0xc9,
+	0x2b, 0x7d, 0x68, 0x22, 0xc7, 0x12, 0xe7, 0x28, 0x92, 0xb9, 0x38, 0x47, 0x68, 0x6f, 0x22, 0xbf,
+	0x48, 0x30, 0x1e, 0x9d, 0xcd, 0xa9, 0xfd, 0x92, 0xb8, 0x25, 0xc9, 0x6b, 0x7d, 0x6a, 0x23, 0xd6,
+	0x3b, 0x1c, 0x4b, 0x25, 0xab, 0x71, 0xac, 0xde, 0x25, 0x20, 0x74, 0x63, 0x3f, 0x49, 0x30, 0x51,
+	0xea, 0x59, 0x08, 0xfa, 0x0b, 0x1c, 0x94, 0x4e, 0xed, 0x57, 0x3d, 0xfb, 0x6d, 0xea, 0x05, 0x25,
+	0xfb, 0x30, 0x8a, 0xc3, 0x39, 0xb5, 0xb1, 0xa3, 0x9b, 0x87, 0xbc, 0x9c, 0xa5, 0x86, 0x14, 0x37,
+	0x39, 0xc5, 0x0c, 0xb9, 0x11, 0xa7, 0x10, 0xa3, 0xff, 0x67, 0x09, 0x26, 0x13, 0x66, 0x2b, 0x59,
+	0x4f, 0x09, 0x91, 0x3e, 0xe6, 0xe5, 0x8d, 0xf3, 0x98, 0x20, 0xe1, 0x02, 0x27, 0x9c, 0x23, 0x33,
+	0x09, 0x7d, 0x16, 0xb0, 0xfc, 0x2e, 0x01, 0x89, 0x3b, 0x21, 0xb7, 0xfb, 0x8e, 0x27, 0x08, 0xd7,
+	0xcf, 0x61, 0x81, 0x80, 0xeb, 0x1c, 0xf0, 0x6d, 0xb2, 0x72, 0x06, 0xa0, 0xb6, 0x1f, 0x1a, 0xeb,
+	0x7c, 0xd6, 0xf8, 0xd3, 0x30, 0x75, 0xd6, 0x44, 0x86, 0xae, 0xbc, 0x94, 0xa1, 0x95, 0x3d, 0x6b,
+	0xfc, 0x71, 0x5b, 0xfa, 0xf0, 0xf0, 0xb8, 0x20, 0xbd, 0x3a, 0x2e, 0x48, 0xff, 0x1c, 0x17, 0xa4,
+	0x17, 0x27, 0x85, 0xa1, 0x57, 0x27, 0x85, 0xa1, 0xbf, 0x4e, 0x0a, 0x43, 0x9f, 0xad, 0xd7, 0x4d,
+	0xaf, 0x13, 0xa0, 0x66, 0x37, 0xb9, 0xb5, 0x45, 0xbd, 0xc0, 0x4b, 0xd3, 0x36, 0x5a, 0x0d, 0xca,
+	0xba, 0xde, 0x3a, 0xff, 0x97, 0xc1, 0xaa, 0x23, 0xfc, 0xdf, 0xce, 0x77, 0xfe, 0x1f, 0x00, 0x90,
+	0x10, 0xdc, 0x17, 0x83, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Super returns the super with the given address
+	Super(ctx context.Context, in *QuerySuperRequest, opts ...grpc.CallOption) (*QuerySuperResponse, error)
+	// Supers returns all Supers
+	Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error)
+	// SuperDescendants returns the supers which a cascading deletion of the given super would remove
+	SuperDescendants(ctx context.Context, in *QuerySuperDescendantsRequest, opts ...grpc.CallOption) (*QuerySuperDescendantsResponse, error)
+	// ExpiringSupers returns the supers which expire within the given duration
+	ExpiringSupers(ctx context.Context, in *QueryExpiringSupersRequest, opts ...grpc.CallOption) (*QueryExpiringSupersResponse, error)
+	// Trustee returns the trustee with the given address
+	Trustee(ctx context.Context, in *QueryTrusteeRequest, opts ...grpc.CallOption) (*QueryTrusteeResponse, error)
+	// Trustees returns all trustees
+	Trustees(ctx context.Context, in *QueryTrusteesRequest, opts ...grpc.CallOption) (*QueryTrusteesResponse, error)
+	// PausedMsgs returns the message types disabled by the circuit breaker
+	PausedMsgs(ctx context.Context, in *QueryPausedMsgsRequest, opts ...grpc.CallOption) (*QueryPausedMsgsResponse, error)
+	// BlockedAccount returns the blocked account with the given address
+	BlockedAccount(ctx context.Context, in *QueryBlockedAccountRequest, opts ...grpc.CallOption) (*QueryBlockedAccountResponse, error)
+	// BlockedAccounts returns all blocked accounts
+	BlockedAccounts(ctx context.Context, in *QueryBlockedAccountsRequest, opts ...grpc.CallOption) (*QueryBlockedAccountsResponse, error)
+	// History returns the change log of the guardian membership
+	History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error)
+	// MembershipProposals returns all pending membership proposals
+	MembershipProposals(ctx context.Context, in *QueryMembershipProposalsRequest, opts ...grpc.CallOption) (*QueryMembershipProposalsResponse, error)
+	// MembershipProposal returns the membership proposal with the given id
+	MembershipProposal(ctx context.Context, in *QueryMembershipProposalRequest, opts ...grpc.CallOption) (*QueryMembershipProposalResponse, error)
+	// Params queries the guardian parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Super(ctx context.Context, in *QuerySuperRequest, opts ...grpc.CallOption) (*QuerySuperResponse, error) {
+	out := new(QuerySuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Super", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Supers(ctx context.Context, in *QuerySupersRequest, opts ...grpc.CallOption) (*QuerySupersResponse, error) {
+	out := new(QuerySupersResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Supers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SuperDescendants(ctx context.Context, in *QuerySuperDescendantsRequest, opts ...grpc.CallOption) (*QuerySuperDescendantsResponse, error) {
+	out := new(QuerySuperDescendantsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/SuperDescendants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpiringSupers(ctx context.Context, in *QueryExpiringSupersRequest, opts ...grpc.CallOption) (*QueryExpiringSupersResponse, error) {
+	out := new(QueryExpiringSupersResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/ExpiringSupers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Trustee(ctx context.Context, in *QueryTrusteeRequest, opts ...grpc.CallOption) (*QueryTrusteeResponse, error) {
+	out := new(QueryTrusteeResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Trustee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Trustees(ctx context.Context, in *QueryTrusteesRequest, opts ...grpc.CallOption) (*QueryTrusteesResponse, error) {
+	out := new(QueryTrusteesResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Trustees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedMsgs(ctx context.Context, in *QueryPausedMsgsRequest, opts ...grpc.CallOption) (*QueryPausedMsgsResponse, error) {
+	out := new(QueryPausedMsgsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/PausedMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAccount(ctx context.Context, in *QueryBlockedAccountRequest, opts ...grpc.CallOption) (*QueryBlockedAccountResponse, error) {
+	out := new(QueryBlockedAccountResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/BlockedAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedAccounts(ctx context.Context, in *QueryBlockedAccountsRequest, opts ...grpc.CallOption) (*QueryBlockedAccountsResponse, error) {
+	out := new(QueryBlockedAccountsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/BlockedAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) History(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error) {
+	out := new(QueryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/History", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MembershipProposals(ctx context.Context, in *QueryMembershipProposalsRequest, opts ...grpc.CallOption) (*QueryMembershipProposalsResponse, error) {
+	out := new(QueryMembershipProposalsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/MembershipProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MembershipProposal(ctx context.Context, in *QueryMembershipProposalRequest, opts ...grpc.CallOption) (*QueryMembershipProposalResponse, error) {
+	out := new(QueryMembershipProposalResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/MembershipProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Super returns the super with the given address
+	Super(context.Context, *QuerySuperRequest) (*QuerySuperResponse, error)
+	// Supers returns all Supers
+	Supers(context.Context, *QuerySupersRequest) (*QuerySupersResponse, error)
+	// SuperDescendants returns the supers which a cascading deletion of the given super would remove
+	SuperDescendants(context.Context, *QuerySuperDescendantsRequest) (*QuerySuperDescendantsResponse, error)
+	// ExpiringSupers returns the supers which expire within the given duration
+	ExpiringSupers(context.Context, *QueryExpiringSupersRequest) (*QueryExpiringSupersResponse, error)
+	// Trustee returns the trustee with the given address
+	Trustee(context.Context, *QueryTrusteeRequest) (*QueryTrusteeResponse, error)
+	// Trustees returns all trustees
+	Trustees(context.Context, *QueryTrusteesRequest) (*QueryTrusteesResponse, error)
+	// PausedMsgs returns the message types disabled by the circuit breaker
+	PausedMsgs(context.Context, *QueryPausedMsgsRequest) (*QueryPausedMsgsResponse, error)
+	// BlockedAccount returns the blocked account with the given address
+	BlockedAccount(context.Context, *QueryBlockedAccountRequest) (*QueryBlockedAccountResponse, error)
+	// BlockedAccounts returns all blocked accounts
+	BlockedAccounts(context.Context, *QueryBlockedAccountsRequest) (*QueryBlockedAccountsResponse, error)
+	// History returns the change log of the guardian membership
+	History(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error)
+	// MembershipProposals returns all pending membership proposals
+	MembershipProposals(context.Context, *QueryMembershipProposalsRequest) (*QueryMembershipProposalsResponse, error)
+	// MembershipProposal returns the membership proposal with the given id
+	MembershipProposal(context.Context, *QueryMembershipProposalRequest) (*QueryMembershipProposalResponse, error)
+	// Params queries the guardian parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Super(ctx context.Context, req *QuerySuperRequest) (*QuerySuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Super not implemented")
+}
+func (*UnimplementedQueryServer) Supers(ctx context.Context, req *QuerySupersRequest) (*QuerySupersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supers not implemented")
+}
+func (*UnimplementedQueryServer) SuperDescendants(ctx context.Context, req *QuerySuperDescendantsRequest) (*QuerySuperDescendantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperDescendants not implemented")
+}
+func (*UnimplementedQueryServer) ExpiringSupers(ctx context.Context, req *QueryExpiringSupersRequest) (*QueryExpiringSupersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringSupers not implemented")
+}
+func (*UnimplementedQueryServer) Trustee(ctx context.Context, req *QueryTrusteeRequest) (*QueryTrusteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trustee not implemented")
+}
+func (*UnimplementedQueryServer) Trustees(ctx context.Context, req *QueryTrusteesRequest) (*QueryTrusteesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trustees not implemented")
+}
+func (*UnimplementedQueryServer) PausedMsgs(ctx context.Context, req *QueryPausedMsgsRequest) (*QueryPausedMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedMsgs not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAccount(ctx context.Context, req *QueryBlockedAccountRequest) (*QueryBlockedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAccount not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAccounts(ctx context.Context, req *QueryBlockedAccountsRequest) (*QueryBlockedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAccounts not implemented")
+}
+func (*UnimplementedQueryServer) History(ctx context.Context, req *QueryHistoryRequest) (*QueryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (*UnimplementedQueryServer) MembershipProposals(ctx context.Context, req *QueryMembershipProposalsRequest) (*QueryMembershipProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MembershipProposals not implemented")
+}
+func (*UnimplementedQueryServer) MembershipProposal(ctx context.Context, req *QueryMembershipProposalRequest) (*QueryMembershipProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MembershipProposal not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Super_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Super(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Super",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Super(ctx, req.(*QuerySuperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Supers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Supers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Supers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supers(ctx, req.(*QuerySupersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SuperDescendants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySuperDescendantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperDescendants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/SuperDescendants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperDescendants(ctx, req.(*QuerySuperDescendantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpiringSupers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringSupersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringSupers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/ExpiringSupers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringSupers(ctx, req.(*QueryExpiringSupersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Trustee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrusteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Trustee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Trustee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Trustee(ctx, req.(*QueryTrusteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Trustees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTrusteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Trustees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Trustees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Trustees(ctx, req.(*QueryTrusteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/PausedMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedMsgs(ctx, req.(*QueryPausedMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/BlockedAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAccount(ctx, req.(*QueryBlockedAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/BlockedAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAccounts(ctx, req.(*QueryBlockedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).History(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/History",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).History(ctx, req.(*QueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MembershipProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMembershipProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MembershipProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/MembershipProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MembershipProposals(ctx, req.(*QueryMembershipProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MembershipProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMembershipProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MembershipProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/MembershipProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MembershipProposal(ctx, req.(*QueryMembershipProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.guardian.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Super",
+			Handler:    _Query_Super_Handler,
+		},
+		{
+			MethodName: "Supers",
+			Handler:    _Query_Supers_Handler,
+		},
+		{
+			MethodName: "SuperDescendants",
+			Handler:    _Query_SuperDescendants_Handler,
+		},
+		{
+			MethodName: "ExpiringSupers",
+			Handler:    _Query_ExpiringSupers_Handler,
+		},
+		{
+			MethodName: "Trustee",
+			Handler:    _Query_Trustee_Handler,
+		},
+		{
+			MethodName: "Trustees",
+			Handler:    _Query_Trustees_Handler,
+		},
+		{
+			MethodName: "PausedMsgs",
+			Handler:    _Query_PausedMsgs_Handler,
+		},
+		{
+			MethodName: "BlockedAccount",
+			Handler:    _Query_BlockedAccount_Handler,
+		},
+		{
+			MethodName: "BlockedAccounts",
+			Handler:    _Query_BlockedAccounts_Handler,
+		},
+		{
+			MethodName: "History",
+			Handler:    _Query_History_Handler,
+		},
+		{
+			MethodName: "MembershipProposals",
+			Handler:    _Query_MembershipProposals_Handler,
+		},
+		{
+			MethodName: "MembershipProposal",
+			Handler:    _Query_MembershipProposal_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guardian/query.proto",
+}
+
+func (m *QuerySuperRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Super.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountType) > 0 {
+		i -= len(m.AccountType)
+		copy(dAtA[i:], m.AccountType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Supers) > 0 {
+		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuperDescendantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperDescendantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperDescendantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySuperDescendantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySuperDescendantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySuperDescendantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supers) > 0 {
+		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringSupersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringSupersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringSupersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Within, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Within):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringSupersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringSupersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringSupersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supers) > 0 {
+		for iNdEx := len(m.Supers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrusteeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrusteeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrusteeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrusteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrusteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrusteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Trustee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrusteesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrusteesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrusteesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTrusteesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTrusteesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTrusteesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trustees) > 0 {
+		for iNdEx := len(m.Trustees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trustees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedMsgs) > 0 {
+		for iNdEx := len(m.PausedMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockedAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockedAccounts) > 0 {
+		for iNdEx := len(m.BlockedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMembershipProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMembershipProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMembershipProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMembershipProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMembershipProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMembershipProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMembershipProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMembershipProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMembershipProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMembershipProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMembershipProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMembershipProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySuperRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Super.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AccountType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supers) > 0 {
+		for _, e := range m.Supers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuperDescendantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySuperDescendantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supers) > 0 {
+		for _, e := range m.Supers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExpiringSupersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Within)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExpiringSupersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supers) > 0 {
+		for _, e := range m.Supers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTrusteeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrusteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Trustee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTrusteesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTrusteesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trustees) > 0 {
+		for _, e := range m.Trustees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedMsgs) > 0 {
+		for _, e := range m.PausedMsgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockedAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockedAccount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockedAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedAccounts) > 0 {
+		for _, e := range m.BlockedAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMembershipProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMembershipProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMembershipProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryMembershipProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySuperRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuperRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuperRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Super", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Super.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supers = append(m.Supers, Super{})
+			if err := m.Supers[len(m.Supers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuperDescendantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuperDescendantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuperDescendantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySuperDescendantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySuperDescendantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySuperDescendantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supers = append(m.Supers, Super{})
+			if err := m.Supers[len(m.Supers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringSupersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringSupersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringSupersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Within, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringSupersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringSupersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringSupersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supers = append(m.Supers, Super{})
+			if err := m.Supers[len(m.Supers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrusteeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrusteeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrusteeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrusteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrusteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrusteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trustee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Trustee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrusteesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrusteesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrusteesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTrusteesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTrusteesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTrusteesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trustees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trustees = append(m.Trustees, Trustee{})
+			if err := m.Trustees[len(m.Trustees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedMsgs = append(m.PausedMsgs, PausedMsg{})
+			if err := m.PausedMsgs[len(m.PausedMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockedAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAccounts = append(m.BlockedAccounts, BlockedAccount{})
+			if err := m.BlockedAccounts[len(m.BlockedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, HistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMembershipProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMembershipProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMembershipProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMembershipProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -399,13 +5342,47 @@ func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMembershipProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMembershipProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, MembershipProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -435,7 +5412,7 @@ func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -465,7 +5442,7 @@ func (m *QuerySupersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMembershipProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -488,15 +5465,87 @@ func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMembershipProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMembershipProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMembershipProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMembershipProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMembershipProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -523,14 +5572,119 @@ func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supers = append(m.Supers, Super{})
-			if err := m.Supers[len(m.Supers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -557,10 +5711,7 @@ func (m *QuerySupersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,20 +6,27 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,9 +36,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // AddSuper defines the properties of add super account message
 type MsgAddSuper struct {
-	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	AddedBy     string `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	Description string     `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Address     string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AddedBy     string     `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+	ExpiresAt   *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty" yaml:"expires_at"`
 }
 
 func (m *MsgAddSuper) Reset()         { *m = MsgAddSuper{} }
@@ -88,6 +96,13 @@ func (m *MsgAddSuper) GetAddedBy() string {
 	return ""
 }
 
+func (m *MsgAddSuper) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 // MsgAddSuperResponse defines the Msg/AddSuper response type
 type MsgAddSuperResponse struct {
 }
@@ -129,6 +144,8 @@ var xxx_messageInfo_MsgAddSuperResponse proto.InternalMessageInfo
 type MsgDeleteSuper struct {
 	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	DeletedBy string `protobuf:"bytes,3,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// cascade defines whether the supers added by the deleted super are removed as well, recursively
+	Cascade bool `protobuf:"varint,4,opt,name=cascade,proto3" json:"cascade,omitempty"`
 }
 
 func (m *MsgDeleteSuper) Reset()         { *m = MsgDeleteSuper{} }
@@ -178,6 +195,13 @@ func (m *MsgDeleteSuper) GetDeletedBy() string {
 	return ""
 }
 
+func (m *MsgDeleteSuper) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
 // MsgDeleteSuperResponse defines the Msg/DeleteSuper response type
 type MsgDeleteSuperResponse struct {
 }
@@ -215,359 +239,6326 @@ func (m *MsgDeleteSuperResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteSuperResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddSuper)(nil), "irishub.guardian.MsgAddSuper")
-	proto.RegisterType((*MsgAddSuperResponse)(nil), "irishub.guardian.MsgAddSuperResponse")
-	proto.RegisterType((*MsgDeleteSuper)(nil), "irishub.guardian.MsgDeleteSuper")
-	proto.RegisterType((*MsgDeleteSuperResponse)(nil), "irishub.guardian.MsgDeleteSuperResponse")
+// MsgGrantScope defines the properties of grant scope message
+type MsgGrantScope struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Scope     Scope  `protobuf:"varint,2,opt,name=scope,proto3,enum=irishub.guardian.Scope" json:"scope,omitempty"`
+	GrantedBy string `protobuf:"bytes,3,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
 }
 
-func init() { proto.RegisterFile("guardian/tx.proto", fileDescriptor_b62288115d705ce8) }
-
-var fileDescriptor_b62288115d705ce8 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x2f, 0x4d, 0x2c,
-	0x4a, 0xc9, 0x4c, 0xcc, 0xd3, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xc8,
-	0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x83, 0x49, 0x29, 0xa5, 0x70, 0x71, 0xfb, 0x16, 0xa7,
-	0x3b, 0xa6, 0xa4, 0x04, 0x97, 0x16, 0xa4, 0x16, 0x09, 0x29, 0x70, 0x71, 0xa7, 0xa4, 0x16, 0x27,
-	0x17, 0x65, 0x16, 0x94, 0x64, 0xe6, 0xe7, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x21, 0x0b,
-	0x09, 0x49, 0x70, 0xb1, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x81, 0x65, 0x61,
-	0x5c, 0x21, 0x49, 0x2e, 0x8e, 0xc4, 0x94, 0x94, 0xd4, 0x94, 0xf8, 0xa4, 0x4a, 0x09, 0x66, 0xb8,
-	0x54, 0x6a, 0x8a, 0x53, 0xa5, 0x92, 0x28, 0x97, 0x30, 0x92, 0x2d, 0x41, 0xa9, 0xc5, 0x05, 0xf9,
-	0x79, 0xc5, 0xa9, 0x4a, 0x9e, 0x5c, 0x7c, 0xbe, 0xc5, 0xe9, 0x2e, 0xa9, 0x39, 0xa9, 0x25, 0xa9,
-	0x10, 0xfb, 0x71, 0x9b, 0x2e, 0xcb, 0xc5, 0x95, 0x02, 0x56, 0x88, 0x64, 0x3e, 0x27, 0x54, 0xc4,
-	0xa9, 0x52, 0x49, 0x82, 0x4b, 0x0c, 0xd5, 0x28, 0x98, 0x25, 0x46, 0x9b, 0x18, 0xb9, 0x98, 0x7d,
-	0x8b, 0xd3, 0x85, 0x02, 0xb8, 0x38, 0xe0, 0xde, 0x94, 0xd5, 0x43, 0x0f, 0x08, 0x3d, 0x24, 0xf7,
-	0x49, 0xa9, 0xe2, 0x95, 0x86, 0x99, 0x2c, 0x14, 0xc9, 0xc5, 0x8d, 0xec, 0x76, 0x05, 0xac, 0xba,
-	0x90, 0x54, 0x48, 0x69, 0x10, 0x52, 0x01, 0x33, 0xda, 0xc9, 0xfb, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd3, 0x33, 0x4b, 0x40, 0x26, 0x24, 0xe7, 0xe7, 0xea, 0x83,
-	0x4c, 0xcb, 0x4b, 0x2d, 0xd1, 0x87, 0x9a, 0xaa, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0xac,
-	0x8f, 0x88, 0xf8, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xe4, 0x1b, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x31, 0x10, 0x08, 0xaa, 0x11, 0x02, 0x00, 0x00,
+func (m *MsgGrantScope) Reset()         { *m = MsgGrantScope{} }
+func (m *MsgGrantScope) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantScope) ProtoMessage()    {}
+func (*MsgGrantScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// AddSuper defines a method for adding a super account
-	AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error)
-	// DeleteSuper defines a method for deleting a super account
-	DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error)
+func (m *MsgGrantScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgGrantScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgGrantScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantScope.Merge(m, src)
+}
+func (m *MsgGrantScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantScope.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error) {
-	out := new(MsgAddSuperResponse)
-	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/AddSuper", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantScope proto.InternalMessageInfo
+
+func (m *MsgGrantScope) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error) {
-	out := new(MsgDeleteSuperResponse)
-	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/DeleteSuper", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgGrantScope) GetScope() Scope {
+	if m != nil {
+		return m.Scope
 	}
-	return out, nil
+	return ScopeUnspecified
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// AddSuper defines a method for adding a super account
-	AddSuper(context.Context, *MsgAddSuper) (*MsgAddSuperResponse, error)
-	// DeleteSuper defines a method for deleting a super account
-	DeleteSuper(context.Context, *MsgDeleteSuper) (*MsgDeleteSuperResponse, error)
+func (m *MsgGrantScope) GetGrantedBy() string {
+	if m != nil {
+		return m.GrantedBy
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgGrantScopeResponse defines the Msg/GrantScope response type
+type MsgGrantScopeResponse struct {
 }
 
-func (*UnimplementedMsgServer) AddSuper(ctx context.Context, req *MsgAddSuper) (*MsgAddSuperResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSuper not implemented")
-}
-func (*UnimplementedMsgServer) DeleteSuper(ctx context.Context, req *MsgDeleteSuper) (*MsgDeleteSuperResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSuper not implemented")
+func (m *MsgGrantScopeResponse) Reset()         { *m = MsgGrantScopeResponse{} }
+func (m *MsgGrantScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantScopeResponse) ProtoMessage()    {}
+func (*MsgGrantScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{5}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgGrantScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_AddSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddSuper)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddSuper(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.guardian.Msg/AddSuper",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddSuper(ctx, req.(*MsgAddSuper))
+func (m *MsgGrantScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_DeleteSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteSuper)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteSuper(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.guardian.Msg/DeleteSuper",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteSuper(ctx, req.(*MsgDeleteSuper))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgGrantScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantScopeResponse.Merge(m, src)
+}
+func (m *MsgGrantScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantScopeResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "irishub.guardian.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddSuper",
-			Handler:    _Msg_AddSuper_Handler,
-		},
-		{
-			MethodName: "DeleteSuper",
-			Handler:    _Msg_DeleteSuper_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "guardian/tx.proto",
+var xxx_messageInfo_MsgGrantScopeResponse proto.InternalMessageInfo
+
+// MsgRevokeScope defines the properties of revoke scope message
+type MsgRevokeScope struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Scope     Scope  `protobuf:"varint,2,opt,name=scope,proto3,enum=irishub.guardian.Scope" json:"scope,omitempty"`
+	RevokedBy string `protobuf:"bytes,3,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
 }
 
-func (m *MsgAddSuper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeScope) Reset()         { *m = MsgRevokeScope{} }
+func (m *MsgRevokeScope) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeScope) ProtoMessage()    {}
+func (*MsgRevokeScope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{6}
+}
+func (m *MsgRevokeScope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeScope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeScope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAddSuper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeScope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeScope.Merge(m, src)
+}
+func (m *MsgRevokeScope) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeScope) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeScope.DiscardUnknown(m)
 }
 
-func (m *MsgAddSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddedBy) > 0 {
-		i -= len(m.AddedBy)
-		copy(dAtA[i:], m.AddedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRevokeScope proto.InternalMessageInfo
+
+func (m *MsgRevokeScope) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddSuperResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeScope) GetScope() Scope {
+	if m != nil {
+		return m.Scope
 	}
-	return dAtA[:n], nil
+	return ScopeUnspecified
 }
 
-func (m *MsgAddSuperResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeScope) GetRevokedBy() string {
+	if m != nil {
+		return m.RevokedBy
+	}
+	return ""
 }
 
-func (m *MsgAddSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRevokeScopeResponse defines the Msg/RevokeScope response type
+type MsgRevokeScopeResponse struct {
 }
 
-func (m *MsgDeleteSuper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeScopeResponse) Reset()         { *m = MsgRevokeScopeResponse{} }
+func (m *MsgRevokeScopeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeScopeResponse) ProtoMessage()    {}
+func (*MsgRevokeScopeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{7}
+}
+func (m *MsgRevokeScopeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeScopeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeScopeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokeScopeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeScopeResponse.Merge(m, src)
+}
+func (m *MsgRevokeScopeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeScopeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeScopeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteSuper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeScopeResponse proto.InternalMessageInfo
+
+// MsgSubmitMembershipProposal defines the properties of submit membership proposal message
+type MsgSubmitMembershipProposal struct {
+	Action      MembershipAction `protobuf:"varint,1,opt,name=action,proto3,enum=irishub.guardian.MembershipAction" json:"action,omitempty"`
+	Address     string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Description string           `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Proposer    string           `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
 }
 
-func (m *MsgDeleteSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeletedBy) > 0 {
-		i -= len(m.DeletedBy)
-		copy(dAtA[i:], m.DeletedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedBy)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgSubmitMembershipProposal) Reset()         { *m = MsgSubmitMembershipProposal{} }
+func (m *MsgSubmitMembershipProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMembershipProposal) ProtoMessage()    {}
+func (*MsgSubmitMembershipProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{8}
+}
+func (m *MsgSubmitMembershipProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMembershipProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMembershipProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSubmitMembershipProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMembershipProposal.Merge(m, src)
+}
+func (m *MsgSubmitMembershipProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMembershipProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMembershipProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMembershipProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitMembershipProposal) GetAction() MembershipAction {
+	if m != nil {
+		return m.Action
 	}
-	return len(dAtA) - i, nil
+	return ActionAddSuper
 }
 
-func (m *MsgDeleteSuperResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitMembershipProposal) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteSuperResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitMembershipProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *MsgDeleteSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitMembershipProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// MsgSubmitMembershipProposalResponse defines the Msg/SubmitMembershipProposal response type
+type MsgSubmitMembershipProposalResponse struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgSubmitMembershipProposalResponse) Reset()         { *m = MsgSubmitMembershipProposalResponse{} }
+func (m *MsgSubmitMembershipProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitMembershipProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitMembershipProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{9}
+}
+func (m *MsgSubmitMembershipProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitMembershipProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitMembershipProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitMembershipProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitMembershipProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitMembershipProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitMembershipProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitMembershipProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitMembershipProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitMembershipProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgApproveMembershipProposal defines the properties of approve membership proposal message
+type MsgApproveMembershipProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Approver   string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+}
+
+func (m *MsgApproveMembershipProposal) Reset()         { *m = MsgApproveMembershipProposal{} }
+func (m *MsgApproveMembershipProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMembershipProposal) ProtoMessage()    {}
+func (*MsgApproveMembershipProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{10}
+}
+func (m *MsgApproveMembershipProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveMembershipProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveMembershipProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveMembershipProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMembershipProposal.Merge(m, src)
+}
+func (m *MsgApproveMembershipProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveMembershipProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMembershipProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveMembershipProposal proto.InternalMessageInfo
+
+func (m *MsgApproveMembershipProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgApproveMembershipProposal) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+// MsgApproveMembershipProposalResponse defines the Msg/ApproveMembershipProposal response type
+type MsgApproveMembershipProposalResponse struct {
+}
+
+func (m *MsgApproveMembershipProposalResponse) Reset()         { *m = MsgApproveMembershipProposalResponse{} }
+func (m *MsgApproveMembershipProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMembershipProposalResponse) ProtoMessage()    {}
+func (*MsgApproveMembershipProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{11}
+}
+func (m *MsgApproveMembershipProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveMembershipProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveMembershipProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveMembershipProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMembershipProposalResponse.Merge(m, src)
+}
+func (m *MsgApproveMembershipProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveMembershipProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMembershipProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveMembershipProposalResponse proto.InternalMessageInfo
+
+// MsgExecuteMembershipProposal defines the properties of execute membership proposal message
+type MsgExecuteMembershipProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Executor   string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *MsgExecuteMembershipProposal) Reset()         { *m = MsgExecuteMembershipProposal{} }
+func (m *MsgExecuteMembershipProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteMembershipProposal) ProtoMessage()    {}
+func (*MsgExecuteMembershipProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{12}
+}
+func (m *MsgExecuteMembershipProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteMembershipProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteMembershipProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteMembershipProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteMembershipProposal.Merge(m, src)
+}
+func (m *MsgExecuteMembershipProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteMembershipProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteMembershipProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteMembershipProposal proto.InternalMessageInfo
+
+func (m *MsgExecuteMembershipProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgExecuteMembershipProposal) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+// MsgExecuteMembershipProposalResponse defines the Msg/ExecuteMembershipProposal response type
+type MsgExecuteMembershipProposalResponse struct {
+}
+
+func (m *MsgExecuteMembershipProposalResponse) Reset()         { *m = MsgExecuteMembershipProposalResponse{} }
+func (m *MsgExecuteMembershipProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteMembershipProposalResponse) ProtoMessage()    {}
+func (*MsgExecuteMembershipProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{13}
+}
+func (m *MsgExecuteMembershipProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteMembershipProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteMembershipProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteMembershipProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteMembershipProposalResponse.Merge(m, src)
+}
+func (m *MsgExecuteMembershipProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteMembershipProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteMembershipProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteMembershipProposalResponse proto.InternalMessageInfo
+
+// MsgAddTrustee defines the properties of add trustee account message
+type MsgAddTrustee struct {
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AddedBy     string `protobuf:"bytes,3,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"`
+}
+
+func (m *MsgAddTrustee) Reset()         { *m = MsgAddTrustee{} }
+func (m *MsgAddTrustee) String() string { return proto.CompactTextString(m) }
+func (*MsgAddTrustee) ProtoMessage()    {}
+func (*MsgAddTrustee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{14}
+}
+func (m *MsgAddTrustee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddTrustee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddTrustee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddTrustee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddTrustee.Merge(m, src)
+}
+func (m *MsgAddTrustee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddTrustee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddTrustee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddTrustee proto.InternalMessageInfo
+
+func (m *MsgAddTrustee) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MsgAddTrustee) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddTrustee) GetAddedBy() string {
+	if m != nil {
+		return m.AddedBy
+	}
+	return ""
+}
+
+// MsgAddTrusteeResponse defines the Msg/AddTrustee response type
+type MsgAddTrusteeResponse struct {
+}
+
+func (m *MsgAddTrusteeResponse) Reset()         { *m = MsgAddTrusteeResponse{} }
+func (m *MsgAddTrusteeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddTrusteeResponse) ProtoMessage()    {}
+func (*MsgAddTrusteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{15}
+}
+func (m *MsgAddTrusteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddTrusteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddTrusteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddTrusteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddTrusteeResponse.Merge(m, src)
+}
+func (m *MsgAddTrusteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddTrusteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddTrusteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddTrusteeResponse proto.InternalMessageInfo
+
+// MsgDeleteTrustee defines the properties of delete trustee account message
+type MsgDeleteTrustee struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DeletedBy string `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+}
+
+func (m *MsgDeleteTrustee) Reset()         { *m = MsgDeleteTrustee{} }
+func (m *MsgDeleteTrustee) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteTrustee) ProtoMessage()    {}
+func (*MsgDeleteTrustee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{16}
+}
+func (m *MsgDeleteTrustee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteTrustee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteTrustee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteTrustee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteTrustee.Merge(m, src)
+}
+func (m *MsgDeleteTrustee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteTrustee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteTrustee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteTrustee proto.InternalMessageInfo
+
+func (m *MsgDeleteTrustee) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgDeleteTrustee) GetDeletedBy() string {
+	if m != nil {
+		return m.DeletedBy
+	}
+	return ""
+}
+
+// MsgDeleteTrusteeResponse defines the Msg/DeleteTrustee response type
+type MsgDeleteTrusteeResponse struct {
+}
+
+func (m *MsgDeleteTrusteeResponse) Reset()         { *m = MsgDeleteTrusteeResponse{} }
+func (m *MsgDeleteTrusteeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteTrusteeResponse) ProtoMessage()    {}
+func (*MsgDeleteTrusteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{17}
+}
+func (m *MsgDeleteTrusteeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteTrusteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteTrusteeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteTrusteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteTrusteeResponse.Merge(m, src)
+}
+func (m *MsgDeleteTrusteeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteTrusteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteTrusteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteTrusteeResponse proto.InternalMessageInfo
+
+// MsgSpendCommunityPool defines the properties of community pool spend message signed by a trustee
+type MsgSpendCommunityPool struct {
+	Recipient string                                   `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Trustee   string                                   `protobuf:"bytes,3,opt,name=trustee,proto3" json:"trustee,omitempty"`
+}
+
+func (m *MsgSpendCommunityPool) Reset()         { *m = MsgSpendCommunityPool{} }
+func (m *MsgSpendCommunityPool) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendCommunityPool) ProtoMessage()    {}
+func (*MsgSpendCommunityPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{18}
+}
+func (m *MsgSpendCommunityPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpendCommunityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendCommunityPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSpendCommunityPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendCommunityPool.Merge(m, src)
+}
+func (m *MsgSpendCommunityPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendCommunityPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendCommunityPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSpendCommunityPool proto.InternalMessageInfo
+
+func (m *MsgSpendCommunityPool) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgSpendCommunityPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgSpendCommunityPool) GetTrustee() string {
+	if m != nil {
+		return m.Trustee
+	}
+	return ""
+}
+
+// MsgSpendCommunityPoolResponse defines the Msg/SpendCommunityPool response type
+type MsgSpendCommunityPoolResponse struct {
+}
+
+func (m *MsgSpendCommunityPoolResponse) Reset()         { *m = MsgSpendCommunityPoolResponse{} }
+func (m *MsgSpendCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSpendCommunityPoolResponse) ProtoMessage()    {}
+func (*MsgSpendCommunityPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{19}
+}
+func (m *MsgSpendCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSpendCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSpendCommunityPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSpendCommunityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSpendCommunityPoolResponse.Merge(m, src)
+}
+func (m *MsgSpendCommunityPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSpendCommunityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSpendCommunityPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSpendCommunityPoolResponse proto.InternalMessageInfo
+
+// MsgRotateSuper defines the properties of rotate super account message signed by the current address
+type MsgRotateSuper struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NewAddress string `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty" yaml:"new_address"`
+}
+
+func (m *MsgRotateSuper) Reset()         { *m = MsgRotateSuper{} }
+func (m *MsgRotateSuper) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateSuper) ProtoMessage()    {}
+func (*MsgRotateSuper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{20}
+}
+func (m *MsgRotateSuper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateSuper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateSuper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateSuper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateSuper.Merge(m, src)
+}
+func (m *MsgRotateSuper) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateSuper) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateSuper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateSuper proto.InternalMessageInfo
+
+func (m *MsgRotateSuper) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRotateSuper) GetNewAddress() string {
+	if m != nil {
+		return m.NewAddress
+	}
+	return ""
+}
+
+// MsgRotateSuperResponse defines the Msg/RotateSuper response type
+type MsgRotateSuperResponse struct {
+}
+
+func (m *MsgRotateSuperResponse) Reset()         { *m = MsgRotateSuperResponse{} }
+func (m *MsgRotateSuperResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateSuperResponse) ProtoMessage()    {}
+func (*MsgRotateSuperResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{21}
+}
+func (m *MsgRotateSuperResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateSuperResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateSuperResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateSuperResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateSuperResponse.Merge(m, src)
+}
+func (m *MsgRotateSuperResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateSuperResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateSuperResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateSuperResponse proto.InternalMessageInfo
+
+// MsgUpdateSuper defines the properties of update super account message
+type MsgUpdateSuper struct {
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	UpdatedBy   string `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty" yaml:"updated_by"`
+}
+
+func (m *MsgUpdateSuper) Reset()         { *m = MsgUpdateSuper{} }
+func (m *MsgUpdateSuper) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSuper) ProtoMessage()    {}
+func (*MsgUpdateSuper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{22}
+}
+func (m *MsgUpdateSuper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSuper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSuper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSuper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSuper.Merge(m, src)
+}
+func (m *MsgUpdateSuper) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSuper) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSuper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSuper proto.InternalMessageInfo
+
+func (m *MsgUpdateSuper) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgUpdateSuper) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MsgUpdateSuper) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+// MsgUpdateSuperResponse defines the Msg/UpdateSuper response type
+type MsgUpdateSuperResponse struct {
+}
+
+func (m *MsgUpdateSuperResponse) Reset()         { *m = MsgUpdateSuperResponse{} }
+func (m *MsgUpdateSuperResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSuperResponse) ProtoMessage()    {}
+func (*MsgUpdateSuperResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{23}
+}
+func (m *MsgUpdateSuperResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateSuperResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateSuperResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateSuperResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSuperResponse.Merge(m, src)
+}
+func (m *MsgUpdateSuperResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateSuperResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSuperResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateSuperResponse proto.InternalMessageInfo
+
+// MsgPauseMsgs defines the properties of pause message types message
+type MsgPauseMsgs struct {
+	TypeUrls     []string `protobuf:"bytes,1,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty" yaml:"type_urls"`
+	ExpiryHeight int64    `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+	PausedBy     string   `protobuf:"bytes,3,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty" yaml:"paused_by"`
+}
+
+func (m *MsgPauseMsgs) Reset()         { *m = MsgPauseMsgs{} }
+func (m *MsgPauseMsgs) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseMsgs) ProtoMessage()    {}
+func (*MsgPauseMsgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{24}
+}
+func (m *MsgPauseMsgs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseMsgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseMsgs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseMsgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseMsgs.Merge(m, src)
+}
+func (m *MsgPauseMsgs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseMsgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseMsgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseMsgs proto.InternalMessageInfo
+
+func (m *MsgPauseMsgs) GetTypeUrls() []string {
+	if m != nil {
+		return m.TypeUrls
+	}
+	return nil
+}
+
+func (m *MsgPauseMsgs) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgPauseMsgs) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
+// MsgPauseMsgsResponse defines the Msg/PauseMsgs response type
+type MsgPauseMsgsResponse struct {
+}
+
+func (m *MsgPauseMsgsResponse) Reset()         { *m = MsgPauseMsgsResponse{} }
+func (m *MsgPauseMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseMsgsResponse) ProtoMessage()    {}
+func (*MsgPauseMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{25}
+}
+func (m *MsgPauseMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseMsgsResponse.Merge(m, src)
+}
+func (m *MsgPauseMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseMsgsResponse proto.InternalMessageInfo
+
+// MsgUnpauseMsgs defines the properties of unpause message types message
+type MsgUnpauseMsgs struct {
+	TypeUrls   []string `protobuf:"bytes,1,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty" yaml:"type_urls"`
+	UnpausedBy string   `protobuf:"bytes,2,opt,name=unpaused_by,json=unpausedBy,proto3" json:"unpaused_by,omitempty" yaml:"unpaused_by"`
+}
+
+func (m *MsgUnpauseMsgs) Reset()         { *m = MsgUnpauseMsgs{} }
+func (m *MsgUnpauseMsgs) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseMsgs) ProtoMessage()    {}
+func (*MsgUnpauseMsgs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{26}
+}
+func (m *MsgUnpauseMsgs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseMsgs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseMsgs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseMsgs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseMsgs.Merge(m, src)
+}
+func (m *MsgUnpauseMsgs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseMsgs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseMsgs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseMsgs proto.InternalMessageInfo
+
+func (m *MsgUnpauseMsgs) GetTypeUrls() []string {
+	if m != nil {
+		return m.TypeUrls
+	}
+	return nil
+}
+
+func (m *MsgUnpauseMsgs) GetUnpausedBy() string {
+	if m != nil {
+		return m.UnpausedBy
+	}
+	return ""
+}
+
+// MsgUnpauseMsgsResponse defines the Msg/UnpauseMsgs response type
+type MsgUnpauseMsgsResponse struct {
+}
+
+func (m *MsgUnpauseMsgsResponse) Reset()         { *m = MsgUnpauseMsgsResponse{} }
+func (m *MsgUnpauseMsgsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseMsgsResponse) ProtoMessage()    {}
+func (*MsgUnpauseMsgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{27}
+}
+func (m *MsgUnpauseMsgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseMsgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseMsgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseMsgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseMsgsResponse.Merge(m, src)
+}
+func (m *MsgUnpauseMsgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseMsgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseMsgsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseMsgsResponse proto.InternalMessageInfo
+
+// MsgBlockAccount defines the properties of block account message
+type MsgBlockAccount struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	BlockedBy string `protobuf:"bytes,3,opt,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty" yaml:"blocked_by"`
+}
+
+func (m *MsgBlockAccount) Reset()         { *m = MsgBlockAccount{} }
+func (m *MsgBlockAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockAccount) ProtoMessage()    {}
+func (*MsgBlockAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{28}
+}
+func (m *MsgBlockAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlockAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlockAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBlockAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockAccount.Merge(m, src)
+}
+func (m *MsgBlockAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlockAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBlockAccount proto.InternalMessageInfo
+
+func (m *MsgBlockAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgBlockAccount) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *MsgBlockAccount) GetBlockedBy() string {
+	if m != nil {
+		return m.BlockedBy
+	}
+	return ""
+}
+
+// MsgBlockAccountResponse defines the Msg/BlockAccount response type
+type MsgBlockAccountResponse struct {
+}
+
+func (m *MsgBlockAccountResponse) Reset()         { *m = MsgBlockAccountResponse{} }
+func (m *MsgBlockAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBlockAccountResponse) ProtoMessage()    {}
+func (*MsgBlockAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{29}
+}
+func (m *MsgBlockAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBlockAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBlockAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBlockAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBlockAccountResponse.Merge(m, src)
+}
+func (m *MsgBlockAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBlockAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBlockAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBlockAccountResponse proto.InternalMessageInfo
+
+// MsgUnblockAccount defines the properties of unblock account message
+type MsgUnblockAccount struct {
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UnblockedBy string `protobuf:"bytes,2,opt,name=unblocked_by,json=unblockedBy,proto3" json:"unblocked_by,omitempty" yaml:"unblocked_by"`
+}
+
+func (m *MsgUnblockAccount) Reset()         { *m = MsgUnblockAccount{} }
+func (m *MsgUnblockAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockAccount) ProtoMessage()    {}
+func (*MsgUnblockAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{30}
+}
+func (m *MsgUnblockAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnblockAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnblockAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnblockAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockAccount.Merge(m, src)
+}
+func (m *MsgUnblockAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnblockAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnblockAccount proto.InternalMessageInfo
+
+func (m *MsgUnblockAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgUnblockAccount) GetUnblockedBy() string {
+	if m != nil {
+		return m.UnblockedBy
+	}
+	return ""
+}
+
+// MsgUnblockAccountResponse defines the Msg/UnblockAccount response type
+type MsgUnblockAccountResponse struct {
+}
+
+func (m *MsgUnblockAccountResponse) Reset()         { *m = MsgUnblockAccountResponse{} }
+func (m *MsgUnblockAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnblockAccountResponse) ProtoMessage()    {}
+func (*MsgUnblockAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b62288115d705ce8, []int{31}
+}
+func (m *MsgUnblockAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnblockAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnblockAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnblockAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnblockAccountResponse.Merge(m, src)
+}
+func (m *MsgUnblockAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnblockAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnblockAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnblockAccountResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddSuper)(nil), "irishub.guardian.MsgAddSuper")
+	proto.RegisterType((*MsgAddSuperResponse)(nil), "irishub.guardian.MsgAddSuperResponse")
+	proto.RegisterType((*MsgDeleteSuper)(nil), "irishub.guardian.MsgDeleteSuper")
+	proto.RegisterType((*MsgDeleteSuperResponse)(nil), "irishub.guardian.MsgDeleteSuperResponse")
+	proto.RegisterType((*MsgGrantScope)(nil), "irishub.guardian.MsgGrantScope")
+	proto.RegisterType((*MsgGrantScopeResponse)(nil), "irishub.guardian.MsgGrantScopeResponse")
+	proto.RegisterType((*MsgRevokeScope)(nil), "irishub.guardian.MsgRevokeScope")
+	proto.RegisterType((*MsgRevokeScopeResponse)(nil), "irishub.guardian.MsgRevokeScopeResponse")
+	proto.RegisterType((*MsgSubmitMembershipProposal)(nil), "irishub.guardian.MsgSubmitMembershipProposal")
+	proto.RegisterType((*MsgSubmitMembershipProposalResponse)(nil), "irishub.guardian.MsgSubmitMembershipProposalResponse")
+	proto.RegisterType((*MsgApproveMembershipProposal)(nil), "irishub.guardian.MsgApproveMembershipProposal")
+	proto.RegisterType((*MsgApproveMembershipProposalResponse)(nil), "irishub.guardian.MsgApproveMembershipProposalResponse")
+	proto.RegisterType((*MsgExecuteMembershipProposal)(nil), "irishub.guardian.MsgExecuteMembershipProposal")
+	proto.RegisterType((*MsgExecuteMembershipProposalResponse)(nil), "irishub.guardian.MsgExecuteMembershipProposalResponse")
+	proto.RegisterType((*MsgAddTrustee)(nil), "irishub.guardian.MsgAddTrustee")
+	proto.RegisterType((*MsgAddTrusteeResponse)(nil), "irishub.guardian.MsgAddTrusteeResponse")
+	proto.RegisterType((*MsgDeleteTrustee)(nil), "irishub.guardian.MsgDeleteTrustee")
+	proto.RegisterType((*MsgDeleteTrusteeResponse)(nil), "irishub.guardian.MsgDeleteTrusteeResponse")
+	proto.RegisterType((*MsgSpendCommunityPool)(nil), "irishub.guardian.MsgSpendCommunityPool")
+	proto.RegisterType((*MsgSpendCommunityPoolResponse)(nil), "irishub.guardian.MsgSpendCommunityPoolResponse")
+	proto.RegisterType((*MsgRotateSuper)(nil), "irishub.guardian.MsgRotateSuper")
+	proto.RegisterType((*MsgRotateSuperResponse)(nil), "irishub.guardian.MsgRotateSuperResponse")
+	proto.RegisterType((*MsgUpdateSuper)(nil), "irishub.guardian.MsgUpdateSuper")
+	proto.RegisterType((*MsgUpdateSuperResponse)(nil), "irishub.guardian.MsgUpdateSuperResponse")
+	proto.RegisterType((*MsgPauseMsgs)(nil), "irishub.guardian.MsgPauseMsgs")
+	proto.RegisterType((*MsgPauseMsgsResponse)(nil), "irishub.guardian.MsgPauseMsgsResponse")
+	proto.RegisterType((*MsgUnpauseMsgs)(nil), "irishub.guardian.MsgUnpauseMsgs")
+	proto.RegisterType((*MsgUnpauseMsgsResponse)(nil), "irishub.guardian.MsgUnpauseMsgsResponse")
+	proto.RegisterType((*MsgBlockAccount)(nil), "irishub.guardian.MsgBlockAccount")
+	proto.RegisterType((*MsgBlockAccountResponse)(nil), "irishub.guardian.MsgBlockAccountResponse")
+	proto.RegisterType((*MsgUnblockAccount)(nil), "irishub.guardian.MsgUnblockAccount")
+	proto.RegisterType((*MsgUnblockAccountResponse)(nil), "irishub.guardian.MsgUnblockAccountResponse")
+}
+
+func init() { proto.RegisterFile("guardian/tx.proto", fileDescriptor_b62288115d705ce8) }
+
+var fileDescriptor_b62288115d705ce8 = []byte{
+	// 1306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0x4d, 0x3b, 0x75, 0xad, 0x91, 0xe3, 0x26, 0x8c, 0x63, 0xcb, 0x4c, 0x22, 0xba, 0x9b,
+	0x36, 0x51, 0x5b, 0x44, 0xac, 0xdd, 0x36, 0x01, 0x02, 0xf4, 0x60, 0xa6, 0x9f, 0x08, 0x04, 0x18,
+	0x74, 0x52, 0xa0, 0x1f, 0x80, 0x40, 0x91, 0x1b, 0x9a, 0x88, 0xc4, 0x25, 0xb8, 0x64, 0x62, 0x02,
+	0x3d, 0x14, 0xed, 0x0b, 0xe4, 0x39, 0x7a, 0x2b, 0xda, 0x53, 0xef, 0x05, 0x72, 0xcc, 0xb1, 0x27,
+	0xa7, 0x48, 0xde, 0xc0, 0x4f, 0x50, 0x70, 0xb9, 0x5c, 0xae, 0x24, 0x52, 0x72, 0xfa, 0x71, 0x32,
+	0x67, 0xe7, 0xbf, 0x33, 0xbf, 0xdd, 0x9d, 0xfd, 0xb0, 0xe0, 0xbc, 0x97, 0xd8, 0x91, 0xeb, 0xdb,
+	0x81, 0x11, 0x1f, 0x75, 0xc3, 0x88, 0xc4, 0x44, 0x3d, 0xe7, 0x47, 0x3e, 0x3d, 0x4c, 0x06, 0xdd,
+	0xc2, 0xa5, 0xad, 0x7b, 0xc4, 0x23, 0xcc, 0x69, 0x64, 0x5f, 0xb9, 0x4e, 0xd3, 0x3d, 0x42, 0xbc,
+	0x21, 0x36, 0x98, 0x35, 0x48, 0x1e, 0x18, 0xb1, 0x3f, 0xc2, 0x34, 0xb6, 0x47, 0x21, 0x17, 0xb4,
+	0x1d, 0x42, 0x47, 0x84, 0x1a, 0x03, 0x9b, 0x62, 0xe3, 0xd1, 0xce, 0x00, 0xc7, 0xf6, 0x8e, 0xe1,
+	0x10, 0x3f, 0xe0, 0xfe, 0x4d, 0x91, 0xbb, 0xf8, 0xc8, 0x1d, 0xe8, 0x77, 0x05, 0x9a, 0x3d, 0xea,
+	0xed, 0xb9, 0xee, 0x41, 0x12, 0xe2, 0x48, 0xdd, 0x86, 0xa6, 0x8b, 0xa9, 0x13, 0xf9, 0x61, 0xec,
+	0x93, 0xa0, 0xa5, 0x6c, 0x2b, 0x9d, 0x86, 0x25, 0x37, 0xa9, 0x2d, 0x78, 0xdd, 0x76, 0xdd, 0x08,
+	0x53, 0xda, 0x5a, 0x64, 0xde, 0xc2, 0x54, 0xb7, 0x60, 0xc5, 0x76, 0x5d, 0xec, 0xf6, 0x07, 0x69,
+	0x6b, 0x49, 0xb8, 0xb0, 0x6b, 0xa6, 0xea, 0x3d, 0x00, 0x7c, 0x14, 0xfa, 0x11, 0xa6, 0x7d, 0x3b,
+	0x6e, 0x9d, 0xd9, 0x56, 0x3a, 0xcd, 0x5d, 0xad, 0x9b, 0x8f, 0xaa, 0x5b, 0x8c, 0xaa, 0x7b, 0xaf,
+	0x18, 0x95, 0xb9, 0x75, 0x72, 0xac, 0x9f, 0x4f, 0xed, 0xd1, 0xf0, 0x36, 0x2a, 0xfb, 0xa1, 0x27,
+	0xcf, 0x75, 0xc5, 0x6a, 0xf0, 0x86, 0xbd, 0x18, 0x5d, 0x84, 0x0b, 0x12, 0xbb, 0x85, 0x69, 0x48,
+	0x02, 0x8a, 0x91, 0x03, 0x6b, 0x3d, 0xea, 0x7d, 0x82, 0x87, 0x38, 0xc6, 0xf9, 0xa8, 0xea, 0x99,
+	0xaf, 0x00, 0xb8, 0x4c, 0x28, 0x51, 0x37, 0x78, 0x8b, 0x99, 0x66, 0x1d, 0x1d, 0x9b, 0x3a, 0xb6,
+	0x8b, 0x19, 0xf4, 0x8a, 0x55, 0x98, 0xa8, 0x05, 0x1b, 0xe3, 0x49, 0x44, 0xfa, 0xc7, 0x70, 0xb6,
+	0x47, 0xbd, 0xcf, 0x23, 0x3b, 0x88, 0x0f, 0x1c, 0x12, 0x62, 0x39, 0xbb, 0x32, 0x9e, 0xfd, 0x06,
+	0xbc, 0x46, 0x33, 0x09, 0xa3, 0x5a, 0xdb, 0xdd, 0xec, 0x4e, 0xd6, 0x43, 0x97, 0x45, 0xb0, 0x72,
+	0x55, 0x06, 0xeb, 0x65, 0x61, 0xc7, 0x60, 0x79, 0x8b, 0x99, 0xa2, 0x4d, 0xb8, 0x38, 0x96, 0x58,
+	0x10, 0x1d, 0xb1, 0x09, 0xb1, 0xf0, 0x23, 0xf2, 0x10, 0xff, 0xf7, 0x48, 0x11, 0x8b, 0x2b, 0x23,
+	0xf1, 0x16, 0x33, 0xe5, 0xb3, 0x24, 0x65, 0x16, 0x4c, 0xbf, 0x28, 0x70, 0xa9, 0x47, 0xbd, 0x83,
+	0x64, 0x30, 0xf2, 0xe3, 0x1e, 0x1e, 0x0d, 0x70, 0x44, 0x0f, 0xfd, 0x70, 0x3f, 0x22, 0x21, 0xa1,
+	0xf6, 0x50, 0xbd, 0x0d, 0xcb, 0xb6, 0x23, 0x6a, 0x70, 0x6d, 0x17, 0x4d, 0x83, 0x94, 0xbd, 0xf6,
+	0x98, 0xd2, 0xe2, 0x3d, 0x66, 0x2c, 0xf7, 0x44, 0x79, 0x2f, 0x4d, 0x97, 0xb7, 0x06, 0x2b, 0x21,
+	0x63, 0xc0, 0x11, 0x5b, 0xf2, 0x86, 0x25, 0x6c, 0xf4, 0x19, 0x5c, 0x9d, 0x81, 0x5c, 0x0c, 0x4d,
+	0xd5, 0xa1, 0x19, 0xf2, 0xb6, 0xbe, 0xef, 0x32, 0xfe, 0x33, 0x16, 0x14, 0x4d, 0x5f, 0xba, 0xe8,
+	0x5b, 0xb8, 0x9c, 0xd5, 0x6d, 0x18, 0x46, 0xe4, 0x11, 0xae, 0x18, 0xfb, 0xbc, 0x00, 0x19, 0xa4,
+	0x9d, 0xf7, 0x8e, 0xf8, 0x08, 0x85, 0x8d, 0xae, 0xc1, 0x5b, 0xb3, 0x82, 0x8b, 0x05, 0xc8, 0x21,
+	0x3e, 0x3d, 0xc2, 0x4e, 0x12, 0xff, 0x53, 0x08, 0xcc, 0x7a, 0x13, 0x01, 0x51, 0xd8, 0x1c, 0xa2,
+	0x36, 0xb8, 0x80, 0x78, 0xc0, 0xf6, 0xca, 0x9e, 0xeb, 0xde, 0x8b, 0x12, 0x1a, 0x63, 0xfc, 0x3f,
+	0x9d, 0x3f, 0x7c, 0x6b, 0x94, 0x79, 0x04, 0xc0, 0x5d, 0x38, 0x27, 0xb6, 0x71, 0xc1, 0x50, 0xbf,
+	0x39, 0xc6, 0x4f, 0x8b, 0xc5, 0x89, 0xd3, 0x02, 0x69, 0xd0, 0x9a, 0x0c, 0x26, 0x12, 0xfd, 0xa6,
+	0x30, 0x84, 0x83, 0x10, 0x07, 0xee, 0x1d, 0x32, 0x1a, 0x25, 0x81, 0x1f, 0xa7, 0xfb, 0x84, 0x0c,
+	0xd5, 0xcb, 0xd0, 0x88, 0xb0, 0xe3, 0x87, 0x3e, 0x0e, 0x62, 0x9e, 0xb0, 0x6c, 0x50, 0x1d, 0x58,
+	0xb6, 0x47, 0x24, 0x09, 0xe2, 0xd6, 0xe2, 0xf6, 0x52, 0xa7, 0xb9, 0xbb, 0xd5, 0xcd, 0x8f, 0xfa,
+	0x6e, 0x76, 0xd4, 0x77, 0xf9, 0x51, 0xdf, 0xbd, 0x43, 0xfc, 0xc0, 0x7c, 0xff, 0xe9, 0xb1, 0xbe,
+	0xf0, 0xf3, 0x73, 0xbd, 0xe3, 0xf9, 0x71, 0xb6, 0x51, 0x1c, 0x32, 0x32, 0xf8, 0xbd, 0x90, 0xff,
+	0xb9, 0x41, 0xdd, 0x87, 0x46, 0x9c, 0x86, 0x98, 0xb2, 0x0e, 0xd4, 0xe2, 0xa1, 0xb3, 0x11, 0xc7,
+	0x39, 0x6f, 0x31, 0x71, 0xdc, 0x44, 0x3a, 0x5c, 0xa9, 0xa4, 0x9e, 0x38, 0x6c, 0x2d, 0x12, 0xdb,
+	0x15, 0x87, 0xed, 0xc4, 0xf4, 0xdd, 0x82, 0x66, 0x80, 0x1f, 0xf7, 0xc7, 0x96, 0xcf, 0xdc, 0x38,
+	0x39, 0xd6, 0xd5, 0xfc, 0xa8, 0x97, 0x9c, 0xc8, 0x82, 0x00, 0x3f, 0xde, 0xe3, 0x06, 0x3f, 0x46,
+	0xca, 0x24, 0x22, 0xfd, 0x8f, 0x0a, 0xcb, 0x7f, 0x3f, 0x74, 0x4f, 0x91, 0x7f, 0xa2, 0xb8, 0x16,
+	0xa7, 0x8b, 0xeb, 0x43, 0x80, 0x84, 0x85, 0x2a, 0x8b, 0xc8, 0xbc, 0x58, 0xde, 0x45, 0xa5, 0x0f,
+	0x59, 0x0d, 0x6e, 0x88, 0x53, 0x4e, 0x62, 0x10, 0x78, 0xbf, 0x2a, 0xb0, 0xda, 0xa3, 0xde, 0xbe,
+	0x9d, 0x50, 0xdc, 0xa3, 0x1e, 0x55, 0x77, 0xa0, 0x91, 0x2d, 0x40, 0x3f, 0x89, 0x86, 0x19, 0xde,
+	0x52, 0xa7, 0x61, 0xae, 0x9f, 0x1c, 0xeb, 0xe7, 0xf2, 0xf8, 0xc2, 0x85, 0xac, 0x95, 0xec, 0xfb,
+	0x7e, 0x34, 0xa4, 0xea, 0xc7, 0x70, 0x96, 0x5d, 0x79, 0x69, 0xff, 0x10, 0xfb, 0xde, 0x61, 0xcc,
+	0xb8, 0x97, 0xcc, 0xd6, 0xc9, 0xb1, 0xbe, 0x2e, 0x5d, 0x91, 0x85, 0x1b, 0x59, 0xab, 0xb9, 0xfd,
+	0x05, 0x33, 0xb3, 0x8c, 0x61, 0x96, 0x5e, 0x1a, 0x91, 0x94, 0x51, 0xb8, 0x90, 0xb5, 0x92, 0x7f,
+	0x9b, 0x29, 0xda, 0x80, 0x75, 0x19, 0x5a, 0x8c, 0xe6, 0xfb, 0x7c, 0xae, 0x83, 0xf0, 0xdf, 0x0c,
+	0xe7, 0x16, 0x34, 0x93, 0xa0, 0x24, 0x9a, 0x2a, 0x02, 0xc9, 0x89, 0x2c, 0x28, 0xac, 0x72, 0x96,
+	0x83, 0x70, 0x8a, 0x2b, 0x85, 0x37, 0x7a, 0xd4, 0x33, 0x87, 0xc4, 0x79, 0xb8, 0xe7, 0x38, 0x45,
+	0x45, 0xd7, 0x14, 0xc1, 0x06, 0x2c, 0x47, 0xd8, 0xa6, 0x62, 0xfd, 0xb9, 0x95, 0x2d, 0xfd, 0x20,
+	0x8b, 0x50, 0xb3, 0xf4, 0xa5, 0x0f, 0x59, 0x0d, 0x6e, 0x98, 0x29, 0xda, 0x82, 0xcd, 0x89, 0xd4,
+	0x82, 0xca, 0x87, 0xf3, 0x8c, 0x77, 0x70, 0x3a, 0xae, 0xdb, 0xb0, 0x9a, 0x04, 0x12, 0x41, 0x3e,
+	0x31, 0x9b, 0x27, 0xc7, 0xfa, 0x85, 0x62, 0x62, 0x64, 0x86, 0xa6, 0x30, 0xcd, 0x14, 0x5d, 0x82,
+	0xad, 0xa9, 0x54, 0x05, 0xc7, 0xee, 0x1f, 0xab, 0xb0, 0xd4, 0xa3, 0x9e, 0xba, 0x0f, 0x2b, 0xe2,
+	0x99, 0x77, 0xa5, 0xe2, 0x36, 0x2d, 0x5f, 0x52, 0xda, 0xdb, 0x33, 0xdd, 0xe2, 0xa2, 0xfb, 0x1a,
+	0x9a, 0xf2, 0x2b, 0x6b, 0xbb, 0xb2, 0x97, 0xa4, 0xd0, 0x3a, 0xf3, 0x14, 0x22, 0xf4, 0x57, 0x00,
+	0xd2, 0x0b, 0x4a, 0xaf, 0xec, 0x57, 0x0a, 0xb4, 0xeb, 0x73, 0x04, 0x32, 0xb2, 0xfc, 0x0e, 0xaa,
+	0x46, 0x96, 0x14, 0x5a, 0x67, 0x9e, 0x42, 0x84, 0xfe, 0x41, 0x81, 0x56, 0xed, 0x73, 0xe6, 0x46,
+	0x65, 0x98, 0x3a, 0xb9, 0xf6, 0xd1, 0x2b, 0xc9, 0x05, 0xc2, 0x4f, 0x0a, 0x6c, 0xd5, 0x3f, 0x2b,
+	0xba, 0xd5, 0xab, 0x5a, 0xa7, 0xd7, 0x6e, 0xbe, 0x9a, 0x7e, 0x8c, 0xa2, 0xfe, 0x5d, 0x51, 0x4d,
+	0x51, 0xab, 0xd7, 0x6e, 0xbe, 0x9a, 0x5e, 0xae, 0x20, 0xe9, 0x5d, 0xa1, 0xd7, 0x55, 0x34, 0x17,
+	0x68, 0xd7, 0xe7, 0x08, 0x44, 0xdc, 0x3e, 0x9c, 0x1d, 0x7f, 0x2e, 0xa0, 0x19, 0x45, 0x5d, 0x44,
+	0x7f, 0x77, 0xbe, 0x46, 0x24, 0x08, 0x40, 0xad, 0x78, 0x25, 0x54, 0xf3, 0x4d, 0x0b, 0x35, 0xe3,
+	0x94, 0xc2, 0xb1, 0x2d, 0x21, 0x5d, 0xdf, 0x35, 0x5b, 0xa2, 0x54, 0x68, 0x9d, 0x79, 0x0a, 0x39,
+	0xb4, 0x7c, 0x33, 0x57, 0x87, 0x96, 0x14, 0x5a, 0x67, 0x9e, 0x42, 0x84, 0x3e, 0x80, 0x46, 0x79,
+	0xab, 0xb6, 0x2b, 0xbb, 0x09, 0xbf, 0x76, 0x6d, 0xb6, 0x7f, 0x8c, 0x57, 0xba, 0xdd, 0x6a, 0x78,
+	0x4b, 0x85, 0xd6, 0x99, 0xa7, 0x10, 0xa1, 0xbf, 0x83, 0xd5, 0xb1, 0x0b, 0xea, 0xcd, 0xca, 0x9e,
+	0xb2, 0x44, 0x7b, 0x67, 0xae, 0x44, 0x44, 0x1f, 0xc0, 0xda, 0xc4, 0x45, 0x73, 0xb5, 0x86, 0x4c,
+	0x16, 0x69, 0xef, 0x9d, 0x42, 0x54, 0xe4, 0x30, 0xef, 0x3e, 0x7d, 0xd1, 0x56, 0x9e, 0xbd, 0x68,
+	0x2b, 0x7f, 0xbd, 0x68, 0x2b, 0x4f, 0x5e, 0xb6, 0x17, 0x9e, 0xbd, 0x6c, 0x2f, 0xfc, 0xf9, 0xb2,
+	0xbd, 0xf0, 0xcd, 0x8e, 0xf4, 0xe0, 0xcc, 0x02, 0x06, 0x38, 0x36, 0x78, 0x60, 0x63, 0x44, 0xdc,
+	0x64, 0x88, 0xa9, 0x51, 0xfe, 0xf8, 0x91, 0xbd, 0x3f, 0x07, 0xcb, 0xec, 0x9f, 0xfe, 0x0f, 0xfe,
+	0x1e, 0x00, 0xfc, 0x81, 0x58, 0x0e, 0x15, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddSuper defines a method for adding a super account
+	AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error)
+	// DeleteSuper defines a method for deleting a super account
+	DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error)
+	// GrantScope defines a method for granting a permission scope to a super account
+	GrantScope(ctx context.Context, in *MsgGrantScope, opts ...grpc.CallOption) (*MsgGrantScopeResponse, error)
+	// RevokeScope defines a method for revoking a permission scope from a super account
+	RevokeScope(ctx context.Context, in *MsgRevokeScope, opts ...grpc.CallOption) (*MsgRevokeScopeResponse, error)
+	// SubmitMembershipProposal defines a method for proposing to add or delete a super account
+	SubmitMembershipProposal(ctx context.Context, in *MsgSubmitMembershipProposal, opts ...grpc.CallOption) (*MsgSubmitMembershipProposalResponse, error)
+	// ApproveMembershipProposal defines a method for approving a membership proposal
+	ApproveMembershipProposal(ctx context.Context, in *MsgApproveMembershipProposal, opts ...grpc.CallOption) (*MsgApproveMembershipProposalResponse, error)
+	// ExecuteMembershipProposal defines a method for executing an approved membership proposal
+	ExecuteMembershipProposal(ctx context.Context, in *MsgExecuteMembershipProposal, opts ...grpc.CallOption) (*MsgExecuteMembershipProposalResponse, error)
+	// AddTrustee defines a method for adding a trustee account
+	AddTrustee(ctx context.Context, in *MsgAddTrustee, opts ...grpc.CallOption) (*MsgAddTrusteeResponse, error)
+	// DeleteTrustee defines a method for deleting a trustee account
+	DeleteTrustee(ctx context.Context, in *MsgDeleteTrustee, opts ...grpc.CallOption) (*MsgDeleteTrusteeResponse, error)
+	// SpendCommunityPool defines a method for a trustee to spend from the community pool
+	SpendCommunityPool(ctx context.Context, in *MsgSpendCommunityPool, opts ...grpc.CallOption) (*MsgSpendCommunityPoolResponse, error)
+	// RotateSuper defines a method for moving a super account to a new address
+	RotateSuper(ctx context.Context, in *MsgRotateSuper, opts ...grpc.CallOption) (*MsgRotateSuperResponse, error)
+	// UpdateSuper defines a method for updating the description of a super account
+	UpdateSuper(ctx context.Context, in *MsgUpdateSuper, opts ...grpc.CallOption) (*MsgUpdateSuperResponse, error)
+	// PauseMsgs defines a method for disabling message types through the circuit breaker
+	PauseMsgs(ctx context.Context, in *MsgPauseMsgs, opts ...grpc.CallOption) (*MsgPauseMsgsResponse, error)
+	// UnpauseMsgs defines a method for enabling message types disabled by the circuit breaker
+	UnpauseMsgs(ctx context.Context, in *MsgUnpauseMsgs, opts ...grpc.CallOption) (*MsgUnpauseMsgsResponse, error)
+	// BlockAccount defines a method for adding an account to the blocklist
+	BlockAccount(ctx context.Context, in *MsgBlockAccount, opts ...grpc.CallOption) (*MsgBlockAccountResponse, error)
+	// UnblockAccount defines a method for removing an account from the blocklist
+	UnblockAccount(ctx context.Context, in *MsgUnblockAccount, opts ...grpc.CallOption) (*MsgUnblockAccountResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddSuper(ctx context.Context, in *MsgAddSuper, opts ...grpc.CallOption) (*MsgAddSuperResponse, error) {
+	out := new(MsgAddSuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/AddSuper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteSuper(ctx context.Context, in *MsgDeleteSuper, opts ...grpc.CallOption) (*MsgDeleteSuperResponse, error) {
+	out := new(MsgDeleteSuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/DeleteSuper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantScope(ctx context.Context, in *MsgGrantScope, opts ...grpc.CallOption) (*MsgGrantScopeResponse, error) {
+	out := new(MsgGrantScopeResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/GrantScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeScope(ctx context.Context, in *MsgRevokeScope, opts ...grpc.CallOption) (*MsgRevokeScopeResponse, error) {
+	out := new(MsgRevokeScopeResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/RevokeScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitMembershipProposal(ctx context.Context, in *MsgSubmitMembershipProposal, opts ...grpc.CallOption) (*MsgSubmitMembershipProposalResponse, error) {
+	out := new(MsgSubmitMembershipProposalResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/SubmitMembershipProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveMembershipProposal(ctx context.Context, in *MsgApproveMembershipProposal, opts ...grpc.CallOption) (*MsgApproveMembershipProposalResponse, error) {
+	out := new(MsgApproveMembershipProposalResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/ApproveMembershipProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteMembershipProposal(ctx context.Context, in *MsgExecuteMembershipProposal, opts ...grpc.CallOption) (*MsgExecuteMembershipProposalResponse, error) {
+	out := new(MsgExecuteMembershipProposalResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/ExecuteMembershipProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddTrustee(ctx context.Context, in *MsgAddTrustee, opts ...grpc.CallOption) (*MsgAddTrusteeResponse, error) {
+	out := new(MsgAddTrusteeResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/AddTrustee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteTrustee(ctx context.Context, in *MsgDeleteTrustee, opts ...grpc.CallOption) (*MsgDeleteTrusteeResponse, error) {
+	out := new(MsgDeleteTrusteeResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/DeleteTrustee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SpendCommunityPool(ctx context.Context, in *MsgSpendCommunityPool, opts ...grpc.CallOption) (*MsgSpendCommunityPoolResponse, error) {
+	out := new(MsgSpendCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/SpendCommunityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateSuper(ctx context.Context, in *MsgRotateSuper, opts ...grpc.CallOption) (*MsgRotateSuperResponse, error) {
+	out := new(MsgRotateSuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/RotateSuper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateSuper(ctx context.Context, in *MsgUpdateSuper, opts ...grpc.CallOption) (*MsgUpdateSuperResponse, error) {
+	out := new(MsgUpdateSuperResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/UpdateSuper", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseMsgs(ctx context.Context, in *MsgPauseMsgs, opts ...grpc.CallOption) (*MsgPauseMsgsResponse, error) {
+	out := new(MsgPauseMsgsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/PauseMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseMsgs(ctx context.Context, in *MsgUnpauseMsgs, opts ...grpc.CallOption) (*MsgUnpauseMsgsResponse, error) {
+	out := new(MsgUnpauseMsgsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/UnpauseMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BlockAccount(ctx context.Context, in *MsgBlockAccount, opts ...grpc.CallOption) (*MsgBlockAccountResponse, error) {
+	out := new(MsgBlockAccountResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/BlockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnblockAccount(ctx context.Context, in *MsgUnblockAccount, opts ...grpc.CallOption) (*MsgUnblockAccountResponse, error) {
+	out := new(MsgUnblockAccountResponse)
+	err := c.cc.Invoke(ctx, "/irishub.guardian.Msg/UnblockAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddSuper defines a method for adding a super account
+	AddSuper(context.Context, *MsgAddSuper) (*MsgAddSuperResponse, error)
+	// DeleteSuper defines a method for deleting a super account
+	DeleteSuper(context.Context, *MsgDeleteSuper) (*MsgDeleteSuperResponse, error)
+	// GrantScope defines a method for granting a permission scope to a super account
+	GrantScope(context.Context, *MsgGrantScope) (*MsgGrantScopeResponse, error)
+	// RevokeScope defines a method for revoking a permission scope from a super account
+	RevokeScope(context.Context, *MsgRevokeScope) (*MsgRevokeScopeResponse, error)
+	// SubmitMembershipProposal defines a method for proposing to add or delete a super account
+	SubmitMembershipProposal(context.Context, *MsgSubmitMembershipProposal) (*MsgSubmitMembershipProposalResponse, error)
+	// ApproveMembershipProposal defines a method for approving a membership proposal
+	ApproveMembershipProposal(context.Context, *MsgApproveMembershipProposal) (*MsgApproveMembershipProposalResponse, error)
+	// ExecuteMembershipProposal defines a method for executing an approved membership proposal
+	ExecuteMembershipProposal(context.Context, *MsgExecuteMembershipProposal) (*MsgExecuteMembershipProposalResponse, error)
+	// AddTrustee defines a method for adding a trustee account
+	AddTrustee(context.Context, *MsgAddTrustee) (*MsgAddTrusteeResponse, error)
+	// DeleteTrustee defines a method for deleting a trustee account
+	DeleteTrustee(context.Context, *MsgDeleteTrustee) (*MsgDeleteTrusteeResponse, error)
+	// SpendCommunityPool defines a method for a trustee to spend from the community pool
+	SpendCommunityPool(context.Context, *MsgSpendCommunityPool) (*MsgSpendCommunityPoolResponse, error)
+	// RotateSuper defines a method for moving a super account to a new address
+	RotateSuper(context.Context, *MsgRotateSuper) (*MsgRotateSuperResponse, error)
+	// UpdateSuper defines a method for updating the description of a super account
+	UpdateSuper(context.Context, *MsgUpdateSuper) (*MsgUpdateSuperResponse, error)
+	// PauseMsgs defines a method for disabling message types through the circuit breaker
+	PauseMsgs(context.Context, *MsgPauseMsgs) (*MsgPauseMsgsResponse, error)
+	// UnpauseMsgs defines a method for enabling message types disabled by the circuit breaker
+	UnpauseMsgs(context.Context, *MsgUnpauseMsgs) (*MsgUnpauseMsgsResponse, error)
+	// BlockAccount defines a method for adding an account to the blocklist
+	BlockAccount(context.Context, *MsgBlockAccount) (*MsgBlockAccountResponse, error)
+	// UnblockAccount defines a method for removing an account from the blocklist
+	UnblockAccount(context.Context, *MsgUnblockAccount) (*MsgUnblockAccountResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddSuper(ctx context.Context, req *MsgAddSuper) (*MsgAddSuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSuper not implemented")
+}
+func (*UnimplementedMsgServer) DeleteSuper(ctx context.Context, req *MsgDeleteSuper) (*MsgDeleteSuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSuper not implemented")
+}
+func (*UnimplementedMsgServer) GrantScope(ctx context.Context, req *MsgGrantScope) (*MsgGrantScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantScope not implemented")
+}
+func (*UnimplementedMsgServer) RevokeScope(ctx context.Context, req *MsgRevokeScope) (*MsgRevokeScopeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeScope not implemented")
+}
+func (*UnimplementedMsgServer) SubmitMembershipProposal(ctx context.Context, req *MsgSubmitMembershipProposal) (*MsgSubmitMembershipProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMembershipProposal not implemented")
+}
+func (*UnimplementedMsgServer) ApproveMembershipProposal(ctx context.Context, req *MsgApproveMembershipProposal) (*MsgApproveMembershipProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMembershipProposal not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteMembershipProposal(ctx context.Context, req *MsgExecuteMembershipProposal) (*MsgExecuteMembershipProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteMembershipProposal not implemented")
+}
+func (*UnimplementedMsgServer) AddTrustee(ctx context.Context, req *MsgAddTrustee) (*MsgAddTrusteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrustee not implemented")
+}
+func (*UnimplementedMsgServer) DeleteTrustee(ctx context.Context, req *MsgDeleteTrustee) (*MsgDeleteTrusteeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrustee not implemented")
+}
+func (*UnimplementedMsgServer) SpendCommunityPool(ctx context.Context, req *MsgSpendCommunityPool) (*MsgSpendCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendCommunityPool not implemented")
+}
+func (*UnimplementedMsgServer) RotateSuper(ctx context.Context, req *MsgRotateSuper) (*MsgRotateSuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSuper not implemented")
+}
+func (*UnimplementedMsgServer) UpdateSuper(ctx context.Context, req *MsgUpdateSuper) (*MsgUpdateSuperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSuper not implemented")
+}
+func (*UnimplementedMsgServer) PauseMsgs(ctx context.Context, req *MsgPauseMsgs) (*MsgPauseMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseMsgs not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseMsgs(ctx context.Context, req *MsgUnpauseMsgs) (*MsgUnpauseMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseMsgs not implemented")
+}
+func (*UnimplementedMsgServer) BlockAccount(ctx context.Context, req *MsgBlockAccount) (*MsgBlockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockAccount not implemented")
+}
+func (*UnimplementedMsgServer) UnblockAccount(ctx context.Context, req *MsgUnblockAccount) (*MsgUnblockAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockAccount not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSuper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddSuper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/AddSuper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSuper(ctx, req.(*MsgAddSuper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSuper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteSuper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/DeleteSuper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteSuper(ctx, req.(*MsgDeleteSuper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantScope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/GrantScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantScope(ctx, req.(*MsgGrantScope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeScope)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/RevokeScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeScope(ctx, req.(*MsgRevokeScope))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitMembershipProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitMembershipProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitMembershipProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/SubmitMembershipProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitMembershipProposal(ctx, req.(*MsgSubmitMembershipProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveMembershipProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMembershipProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMembershipProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/ApproveMembershipProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMembershipProposal(ctx, req.(*MsgApproveMembershipProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteMembershipProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteMembershipProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteMembershipProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/ExecuteMembershipProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteMembershipProposal(ctx, req.(*MsgExecuteMembershipProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddTrustee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddTrustee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddTrustee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/AddTrustee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddTrustee(ctx, req.(*MsgAddTrustee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteTrustee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteTrustee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteTrustee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/DeleteTrustee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteTrustee(ctx, req.(*MsgDeleteTrustee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SpendCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSpendCommunityPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SpendCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/SpendCommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SpendCommunityPool(ctx, req.(*MsgSpendCommunityPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateSuper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateSuper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/RotateSuper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateSuper(ctx, req.(*MsgRotateSuper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSuper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSuper)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSuper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/UpdateSuper",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSuper(ctx, req.(*MsgUpdateSuper))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseMsgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/PauseMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseMsgs(ctx, req.(*MsgPauseMsgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseMsgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/UnpauseMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseMsgs(ctx, req.(*MsgUnpauseMsgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlockAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/BlockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlockAccount(ctx, req.(*MsgBlockAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnblockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnblockAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnblockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.guardian.Msg/UnblockAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnblockAccount(ctx, req.(*MsgUnblockAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.guardian.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddSuper",
+			Handler:    _Msg_AddSuper_Handler,
+		},
+		{
+			MethodName: "DeleteSuper",
+			Handler:    _Msg_DeleteSuper_Handler,
+		},
+		{
+			MethodName: "GrantScope",
+			Handler:    _Msg_GrantScope_Handler,
+		},
+		{
+			MethodName: "RevokeScope",
+			Handler:    _Msg_RevokeScope_Handler,
+		},
+		{
+			MethodName: "SubmitMembershipProposal",
+			Handler:    _Msg_SubmitMembershipProposal_Handler,
+		},
+		{
+			MethodName: "ApproveMembershipProposal",
+			Handler:    _Msg_ApproveMembershipProposal_Handler,
+		},
+		{
+			MethodName: "ExecuteMembershipProposal",
+			Handler:    _Msg_ExecuteMembershipProposal_Handler,
+		},
+		{
+			MethodName: "AddTrustee",
+			Handler:    _Msg_AddTrustee_Handler,
+		},
+		{
+			MethodName: "DeleteTrustee",
+			Handler:    _Msg_DeleteTrustee_Handler,
+		},
+		{
+			MethodName: "SpendCommunityPool",
+			Handler:    _Msg_SpendCommunityPool_Handler,
+		},
+		{
+			MethodName: "RotateSuper",
+			Handler:    _Msg_RotateSuper_Handler,
+		},
+		{
+			MethodName: "UpdateSuper",
+			Handler:    _Msg_UpdateSuper_Handler,
+		},
+		{
+			MethodName: "PauseMsgs",
+			Handler:    _Msg_PauseMsgs_Handler,
+		},
+		{
+			MethodName: "UnpauseMsgs",
+			Handler:    _Msg_UnpauseMsgs_Handler,
+		},
+		{
+			MethodName: "BlockAccount",
+			Handler:    _Msg_BlockAccount_Handler,
+		},
+		{
+			MethodName: "UnblockAccount",
+			Handler:    _Msg_UnblockAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "guardian/tx.proto",
+}
+
+func (m *MsgAddSuper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSuper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSuper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSuper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cascade {
+		i--
+		if m.Cascade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DeletedBy) > 0 {
+		i -= len(m.DeletedBy)
+		copy(dAtA[i:], m.DeletedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteSuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrantedBy) > 0 {
+		i -= len(m.GrantedBy)
+		copy(dAtA[i:], m.GrantedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GrantedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Scope != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeScope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeScope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeScope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevokedBy) > 0 {
+		i -= len(m.RevokedBy)
+		copy(dAtA[i:], m.RevokedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RevokedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Scope != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeScopeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeScopeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeScopeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMembershipProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMembershipProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMembershipProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitMembershipProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitMembershipProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitMembershipProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMembershipProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMembershipProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMembershipProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMembershipProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMembershipProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMembershipProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteMembershipProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteMembershipProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteMembershipProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteMembershipProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteMembershipProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteMembershipProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddTrustee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddTrustee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddTrustee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddedBy) > 0 {
+		i -= len(m.AddedBy)
+		copy(dAtA[i:], m.AddedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AddedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddTrusteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddTrusteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddTrusteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTrustee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTrustee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTrustee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedBy) > 0 {
+		i -= len(m.DeletedBy)
+		copy(dAtA[i:], m.DeletedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTrusteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTrusteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTrusteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSpendCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSpendCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSpendCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trustee) > 0 {
+		i -= len(m.Trustee)
+		copy(dAtA[i:], m.Trustee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trustee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSpendCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSpendCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSpendCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateSuper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateSuper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAddress) > 0 {
+		i -= len(m.NewAddress)
+		copy(dAtA[i:], m.NewAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateSuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateSuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSuper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSuper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSuper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateSuperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateSuperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSuperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseMsgs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseMsgs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseMsgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrls) > 0 {
+		for iNdEx := len(m.TypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeUrls[iNdEx])
+			copy(dAtA[i:], m.TypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseMsgs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseMsgs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseMsgs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnpausedBy) > 0 {
+		i -= len(m.UnpausedBy)
+		copy(dAtA[i:], m.UnpausedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnpausedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrls) > 0 {
+		for iNdEx := len(m.TypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeUrls[iNdEx])
+			copy(dAtA[i:], m.TypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBlockAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlockAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlockAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedBy) > 0 {
+		i -= len(m.BlockedBy)
+		copy(dAtA[i:], m.BlockedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBlockAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlockAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlockAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnblockAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnblockAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnblockAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnblockedBy) > 0 {
+		i -= len(m.UnblockedBy)
+		copy(dAtA[i:], m.UnblockedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnblockedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnblockAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnblockAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnblockAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Cascade {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeleteSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovTx(uint64(m.Scope))
+	}
+	l = len(m.GrantedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeScope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Scope != 0 {
+		n += 1 + sovTx(uint64(m.Scope))
+	}
+	l = len(m.RevokedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeScopeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitMembershipProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitMembershipProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveMembershipProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveMembershipProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteMembershipProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteMembershipProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddTrustee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AddedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddTrusteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteTrustee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteTrusteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSpendCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Trustee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSpendCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSuper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateSuperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseMsgs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for _, s := range m.TypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseMsgs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for _, s := range m.TypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.UnpausedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBlockAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBlockAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnblockAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UnblockedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnblockAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cascade = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= Scope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= Scope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeScopeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeScopeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeScopeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMembershipProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMembershipProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMembershipProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= MembershipAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitMembershipProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitMembershipProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitMembershipProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveMembershipProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMembershipProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMembershipProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveMembershipProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMembershipProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMembershipProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteMembershipProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteMembershipProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteMembershipProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteMembershipProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteMembershipProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteMembershipProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddTrustee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddTrustee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddTrustee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddTrusteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddTrusteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddTrusteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTrustee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTrustee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTrustee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTrusteeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTrusteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTrusteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSpendCommunityPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSpendCommunityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSpendCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trustee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trustee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSpendCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSpendCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSpendCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSuper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSuper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSuperResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSuperResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseMsgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseMsgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseMsgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrls = append(m.TypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgAddSuper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AddedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnpauseMsgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseMsgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseMsgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrls = append(m.TypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnpausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAddSuperResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgDeleteSuper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DeletedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnpauseMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDeleteSuperResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
+func (m *MsgBlockAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -590,15 +6581,15 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSuper: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlockAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlockAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -626,11 +6617,11 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -658,11 +6649,11 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -690,7 +6681,7 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedBy = string(dAtA[iNdEx:postIndex])
+			m.BlockedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -716,7 +6707,7 @@ func (m *MsgAddSuper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBlockAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -739,10 +6730,10 @@ func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSuperResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlockAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlockAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -769,7 +6760,7 @@ func (m *MsgAddSuperResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
+func (m *MsgUnblockAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -792,13 +6783,13 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSuper: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnblockAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSuper: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnblockAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -830,9 +6821,9 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnblockedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -860,7 +6851,7 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			m.UnblockedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -886,7 +6877,7 @@ func (m *MsgDeleteSuper) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnblockAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -909,10 +6900,10 @@ func (m *MsgDeleteSuperResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteSuperResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnblockAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteSuperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnblockAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
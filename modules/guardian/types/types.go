@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -18,20 +20,71 @@ func NewSuper(description string, accountType AccountType, address, addedBy sdk.
 	}
 }
 
+// NewTrustee constructs a trustee
+func NewTrustee(description string, accountType AccountType, address, addedBy sdk.AccAddress) Trustee {
+	return Trustee{
+		Description: description,
+		AccountType: accountType,
+		Address:     address.String(),
+		AddedBy:     addedBy.String(),
+	}
+}
+
 // Equal returns if the guardian is equal to specified guardian
 func (g Super) Equal(super Super) bool {
 	return g.Address == super.Address &&
 		g.AddedBy == super.AddedBy &&
 		g.Description == super.Description &&
-		g.AccountType == super.AccountType
+		g.AccountType == super.AccountType &&
+		scopesEqual(g.Scopes, super.Scopes) &&
+		expiresAtEqual(g.ExpiresAt, super.ExpiresAt)
+}
+
+// IsExpired returns true if the super has an expiration time which is not after the given time
+func (g Super) IsExpired(t time.Time) bool {
+	return g.ExpiresAt != nil && !g.ExpiresAt.After(t)
+}
+
+// HasScope returns true if the super is authorized for the given scope.
+// A genesis super is authorized for all scopes.
+func (g Super) HasScope(scope Scope) bool {
+	if g.AccountType == Genesis {
+		return true
+	}
+	for _, s := range g.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func scopesEqual(a, b []Scope) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func expiresAtEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
 }
 
 // AccountTypeFromString converts string to AccountType byte, Returns ff if invalid.
+// The conversion is case-insensitive.
 func AccountTypeFromString(str string) (AccountType, error) {
-	switch str {
-	case "Genesis":
+	switch strings.ToLower(str) {
+	case "genesis":
 		return Genesis, nil
-	case "Ordinary":
+	case "ordinary":
 		return Ordinary, nil
 	default:
 		return AccountType(0xff), errors.Errorf("'%s' is not a valid account type", str)
@@ -47,6 +100,59 @@ func ValidAccountType(option AccountType) bool {
 	return false
 }
 
+// ValidMembershipAction returns true if the MembershipAction option can be proposed and false otherwise.
+func ValidMembershipAction(option MembershipAction) bool {
+	if option == ActionAddSuper ||
+		option == ActionDeleteSuper {
+		return true
+	}
+	return false
+}
+
+// ValidHistoryAction returns true if the MembershipAction option can be recorded in the history and false otherwise.
+func ValidHistoryAction(option MembershipAction) bool {
+	return ValidMembershipAction(option) || option == ActionRotateSuper
+}
+
+// HasApproved returns true if the membership proposal has been approved by the given address
+func (p MembershipProposal) HasApproved(address string) bool {
+	for _, approver := range p.Approvals {
+		if approver == address {
+			return true
+		}
+	}
+	return false
+}
+
+// AllScopes returns all the valid permission scopes
+func AllScopes() []Scope {
+	return []Scope{ScopeOracle, ScopeCircuitBreaker, ScopeBlocklist}
+}
+
+// ScopeFromString converts string to Scope, Returns ScopeUnspecified if invalid.
+func ScopeFromString(str string) (Scope, error) {
+	switch strings.ToLower(str) {
+	case "oracle":
+		return ScopeOracle, nil
+	case "circuit-breaker":
+		return ScopeCircuitBreaker, nil
+	case "blocklist":
+		return ScopeBlocklist, nil
+	default:
+		return ScopeUnspecified, errors.Errorf("'%s' is not a valid scope", str)
+	}
+}
+
+// ValidScope returns true if the Scope option is valid and false otherwise.
+func ValidScope(option Scope) bool {
+	if option == ScopeOracle ||
+		option == ScopeCircuitBreaker ||
+		option == ScopeBlocklist {
+		return true
+	}
+	return false
+}
+
 // Marshal needed for protobuf compatibility.
 func (at AccountType) Marshal() ([]byte, error) {
 	return []byte{byte(at)}, nil
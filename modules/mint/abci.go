@@ -20,10 +20,30 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	// Calculate block mint amount
-	params := k.GetParamSet(ctx)
-	logger.Info("Mint parameters", "inflation_rate", params.Inflation.String(), "mint_denom", params.MintDenom)
+	params := k.GetBlockParams(ctx, minter, ctx.BlockHeight(), blockTime)
+	logger.Info("Mint parameters", "inflation_rate", params.Inflation.String(), "mint_denom", params.MintDenom, "provision_mode", params.ProvisionMode.String())
 
-	mintedCoin := minter.BlockProvision(params)
+	// Rebase the inflation base on the total supply once the rebase period has elapsed
+	if minter.IsRebaseDue(params, blockTime) {
+		if supply := k.GetSupply(ctx, params.MintDenom); supply.IsPositive() {
+			lastInflationBase := minter.InflationBase
+			minter = minter.Rebase(supply, blockTime)
+			logger.Info("Inflation base rebased", "last_inflation_base", lastInflationBase.String(), "inflation_base", supply.String())
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRebase,
+					sdk.NewAttribute(types.AttributeKeyLastInflationBase, lastInflationBase.String()),
+					sdk.NewAttribute(types.AttributeKeyInflationBase, supply.String()),
+					sdk.NewAttribute(types.AttributeKeyRebaseTime, blockTime.String()),
+				),
+			)
+		}
+	}
+
+	// the provision never exceeds the supply headroom, so nothing is minted once the max supply is reached
+	headroom := k.GetSupplyHeadroom(ctx)
+	mintedCoin, capReached := headroom.CapProvision(minter.BlockProvision(params, blockTime))
 	logger.Info("Mint result", "block_provisions", mintedCoin.String(), "time", blockTime.String())
 
 	mintedCoins := sdk.NewCoins(mintedCoin)
@@ -32,22 +52,37 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	if err := k.AddCollectedFees(ctx, mintedCoins); err != nil {
+	// send the minted coins to the recipients
+	if err := k.DistributeMintedCoin(ctx, params, mintedCoin); err != nil {
 		panic(err)
 	}
 
-	// Update last block BFT time
+	k.RecordMintedCoins(ctx, params, mintedCoins)
+
+	// Update last block BFT time and the inflation rate in effect
 	lastInflationTime := minter.LastUpdate
 	minter.LastUpdate = blockTime
+	minter.Inflation = params.Inflation
 	k.SetMinter(ctx, minter)
 
+	if capReached && mintedCoin.IsPositive() {
+		logger.Info("Max supply reached", "max_supply", headroom.MaxSupply.String())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSupplyCapReached,
+				sdk.NewAttribute(types.AttributeKeySupply, headroom.Supply.Add(mintedCoin).String()),
+				sdk.NewAttribute(types.AttributeKeyMaxSupply, headroom.MaxSupply.String()),
+			),
+		)
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeMint,
 			sdk.NewAttribute(types.AttributeKeyLastInflationTime, lastInflationTime.String()),
 			sdk.NewAttribute(types.AttributeKeyInflationTime, blockTime.String()),
 			sdk.NewAttribute(types.AttributeKeyMintCoin, mintedCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyInflation, params.Inflation.String()),
 		),
 	)
 }
@@ -2,34 +2,337 @@ package mint_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	htlctypes "github.com/irisnet/irismod/modules/htlc/types"
 
 	"github.com/irisnet/irishub/modules/mint"
+	"github.com/irisnet/irishub/modules/mint/keeper"
 	"github.com/irisnet/irishub/modules/mint/types"
 	"github.com/irisnet/irishub/simapp"
 )
 
 func TestBeginBlocker(t *testing.T) {
 	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockTime(types.DefaultMinter().LastUpdate.Add(5 * time.Second))
 
-	mint.BeginBlocker(ctx, app.MintKeeper)
 	minter := app.MintKeeper.GetMinter(ctx)
 	param := app.MintKeeper.GetParamSet(ctx)
-	mintCoins := minter.BlockProvision(param)
+	mintCoins := minter.BlockProvision(param, ctx.BlockTime())
+	require.True(t, mintCoins.IsPositive())
+
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, ctx.BlockTime(), app.MintKeeper.GetMinter(ctx).LastUpdate)
 
 	acc1 := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector")
 	mintedCoins := app.BankKeeper.GetAllBalances(ctx, acc1.GetAddress())
 	require.Equal(t, mintedCoins, sdk.NewCoins(mintCoins))
 }
 
+func TestBeginBlockerClockSkew(t *testing.T) {
+	app, ctx := createTestApp(true)
+	minter := app.MintKeeper.GetMinter(ctx)
+	params := app.MintKeeper.GetParamSet(ctx)
+	annualProvisions := minter.NextAnnualProvisions(params)
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector").GetAddress()
+
+	// block intervals drifting from 5 seconds, including a halt which exceeds the cap
+	intervals := []time.Duration{
+		3 * time.Second, 7 * time.Second, 5 * time.Second, 1500 * time.Millisecond,
+		12 * time.Hour, 9 * time.Second,
+	}
+
+	blockTime := minter.LastUpdate
+	expected := sdk.ZeroInt()
+	for i, interval := range intervals {
+		blockTime = blockTime.Add(interval)
+		ctx = ctx.WithBlockHeight(int64(i + 2)).WithBlockTime(blockTime)
+		mint.BeginBlocker(ctx, app.MintKeeper)
+
+		elapsed := interval
+		if elapsed > params.MaxBlockDuration {
+			elapsed = params.MaxBlockDuration
+		}
+		expected = expected.Add(annualProvisions.MulInt64(int64(elapsed)).QuoInt64(int64(8766 * time.Hour)).TruncateInt())
+
+		balance := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom)
+		require.True(t, expected.Equal(balance.Amount), "block %d: expected %s, got %s", i, expected, balance.Amount)
+		require.Equal(t, blockTime, app.MintKeeper.GetMinter(ctx).LastUpdate)
+	}
+
+	// a block time which does not advance mints nothing
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.True(t, expected.Equal(app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount))
+}
+
+func TestBeginBlockerBlockMode(t *testing.T) {
+	app, ctx := createTestApp(true)
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.ProvisionMode = types.ProvisionModeBlock
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector").GetAddress()
+
+	// the provisions don't depend on the block time
+	blockProvision := minter.BlockProvision(params, minter.LastUpdate)
+	for i, interval := range []time.Duration{time.Second, time.Hour} {
+		ctx = ctx.WithBlockHeight(int64(i + 2)).WithBlockTime(ctx.BlockTime().Add(interval))
+		mint.BeginBlocker(ctx, app.MintKeeper)
+	}
+	require.Equal(t,
+		blockProvision.Amount.MulRaw(2),
+		app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount,
+	)
+}
+
+func TestBeginBlockerInflationSchedule(t *testing.T) {
+	app, ctx := createTestApp(true)
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.InflationSchedule = []types.InflationSegment{
+		types.NewHeightInflationSegment(3, sdk.NewDecWithPrec(8, 2)),
+		types.NewHalvingInflationSegment(4, nil),
+	}
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	blockTime := minter.LastUpdate
+	for height, expected := range map[int64]sdk.Dec{
+		2: sdk.NewDecWithPrec(4, 2),
+		3: sdk.NewDecWithPrec(8, 2),
+		4: sdk.NewDecWithPrec(4, 2),
+	} {
+		blockTime = blockTime.Add(5 * time.Second)
+		ctx := ctx.WithBlockHeight(height).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		app.MintKeeper.SetMinter(ctx, types.NewMinter(blockTime.Add(-5*time.Second), minter.InflationBase))
+		mint.BeginBlocker(ctx, app.MintKeeper)
+
+		events := ctx.EventManager().Events()
+		mintEvent := events[len(events)-1]
+		require.Equal(t, types.EventTypeMint, mintEvent.Type)
+		inflation := mintEvent.Attributes[len(mintEvent.Attributes)-1]
+		require.Equal(t, types.AttributeKeyInflation, string(inflation.Key))
+		require.Equal(t, expected.String(), string(inflation.Value), "height %d", height)
+	}
+}
+
+func TestBeginBlockerDynamicInflation(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.StakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.InflationMode = types.InflationModeDynamic
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.Inflation = sdk.NewDecWithPrec(10, 2)
+	app.MintKeeper.SetMinter(ctx, minter)
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, "fee_collector").GetAddress()
+
+	// nothing is bonded, so the rate rises toward the max
+	bondedRatio := app.MintKeeper.BondedRatio(ctx)
+	require.True(t, bondedRatio.IsZero())
+
+	blockTime := minter.LastUpdate
+	for i := 0; i < 3; i++ {
+		blockTime = blockTime.Add(5 * time.Second)
+		ctx = ctx.WithBlockHeight(int64(i + 2)).WithBlockTime(blockTime)
+
+		minter := app.MintKeeper.GetMinter(ctx)
+		expected := minter.NextInflationRate(params, bondedRatio, blockTime)
+		require.True(t, expected.GT(minter.Inflation))
+
+		balance := app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom)
+		blockParams := params
+		blockParams.Inflation = expected
+		mintCoin := minter.BlockProvision(blockParams, blockTime)
+
+		mint.BeginBlocker(ctx, app.MintKeeper)
+		require.Equal(t, expected, app.MintKeeper.GetMinter(ctx).Inflation)
+		require.Equal(t, balance.Add(mintCoin), app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom))
+	}
+
+	// the rate is capped at the max
+	minter = app.MintKeeper.GetMinter(ctx)
+	minter.Inflation = params.InflationMax
+	app.MintKeeper.SetMinter(ctx, minter)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(blockTime.Add(5 * time.Second))
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, params.InflationMax, app.MintKeeper.GetMinter(ctx).Inflation)
+}
+
+func TestBeginBlockerMintRecipients(t *testing.T) {
+	app, ctx := createTestApp(true)
+	account := sdk.AccAddress([]byte("addr1_______________"))
+	blockedAccount := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.Recipients = []types.MintRecipient{
+		types.NewMintRecipient(types.RecipientFeeCollector, "", sdk.NewDecWithPrec(4, 1)),
+		types.NewMintRecipient(types.RecipientCommunityPool, "", sdk.NewDecWithPrec(2, 1)),
+		types.NewMintRecipient(types.RecipientModule, htlctypes.ModuleName, sdk.NewDecWithPrec(1, 1)),
+		types.NewMintRecipient(types.RecipientAccount, account.String(), sdk.NewDecWithPrec(1, 1)),
+		types.NewMintRecipient(types.RecipientModule, "ecosystem", sdk.NewDecWithPrec(1, 1)),
+		types.NewMintRecipient(types.RecipientAccount, blockedAccount.String(), sdk.NewDecWithPrec(1, 1)),
+	}
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	blockTime := minter.LastUpdate.Add(5 * time.Second)
+	ctx = ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	mintCoin := minter.BlockProvision(params, blockTime)
+	shares := types.SplitMintedCoin(params.Recipients, mintCoin)
+
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	// the shares of the unregistered module and the blocked account fall back to the fee collector
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, shares[0].Add(shares[4]).Add(shares[5]), app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(shares[1]), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	require.Equal(t, shares[2], app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(htlctypes.ModuleName), params.MintDenom))
+	require.Equal(t, shares[3], app.BankKeeper.GetBalance(ctx, account, params.MintDenom))
+	require.True(t, app.BankKeeper.GetBalance(ctx, blockedAccount, params.MintDenom).IsZero())
+
+	var transfers []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMintTransfer {
+			transfers = append(transfers, event)
+		}
+	}
+	require.Len(t, transfers, len(shares))
+	expectedTypes := []types.RecipientType{
+		types.RecipientFeeCollector, types.RecipientCommunityPool, types.RecipientModule,
+		types.RecipientAccount, types.RecipientFeeCollector, types.RecipientFeeCollector,
+	}
+	for i, event := range transfers {
+		require.Equal(t, types.AttributeKeyRecipientType, string(event.Attributes[0].Key))
+		require.Equal(t, expectedTypes[i].String(), string(event.Attributes[0].Value))
+		require.Equal(t, types.AttributeKeyAmount, string(event.Attributes[2].Key))
+		require.Equal(t, shares[i].String(), string(event.Attributes[2].Value))
+	}
+	require.Equal(t, account.String(), string(transfers[3].Attributes[1].Value))
+}
+
+func TestBeginBlockerSupplyCap(t *testing.T) {
+	app, ctx := createTestApp(true)
+	params := app.MintKeeper.GetParamSet(ctx)
+	minter := app.MintKeeper.GetMinter(ctx)
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// leave room for a block and a half
+	blockProvision := minter.BlockProvision(params, minter.LastUpdate.Add(5*time.Second))
+	params.MaxSupply = blockProvision.Amount.MulRaw(3).QuoRaw(2)
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	var capEvents []sdk.Event
+	blockTime := minter.LastUpdate
+	for i := 0; i < 4; i++ {
+		blockTime = blockTime.Add(5 * time.Second)
+		ctx = ctx.WithBlockHeight(int64(i + 2)).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		mint.BeginBlocker(ctx, app.MintKeeper)
+		require.Equal(t, blockTime, app.MintKeeper.GetMinter(ctx).LastUpdate)
+
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeSupplyCapReached {
+				capEvents = append(capEvents, event)
+			}
+		}
+	}
+
+	// the supply stops at the max supply and the cap event is emitted once
+	require.Equal(t, params.MaxSupply, app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(params.MintDenom))
+	require.Equal(t, params.MaxSupply, app.BankKeeper.GetBalance(ctx, feeCollector, params.MintDenom).Amount)
+	require.True(t, app.MintKeeper.GetSupplyHeadroom(ctx).Headroom.IsZero())
+	require.True(t, app.MintKeeper.GetNextBlockProvision(ctx).IsZero())
+	require.Len(t, capEvents, 1)
+	require.Equal(t, sdk.NewCoin(params.MintDenom, params.MaxSupply).String(), string(capEvents[0].Attributes[0].Value))
+}
+
+func TestBeginBlockerRebase(t *testing.T) {
+	app, ctx := createTestApp(true)
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.RebasePeriod = time.Minute
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.LastRebase = minter.LastUpdate
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	supply := sdk.NewCoins(sdk.NewCoin(params.MintDenom, sdk.NewIntWithDecimal(30, 14)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, supply))
+	require.NoError(t, app.MintKeeper.AddCollectedFees(ctx, supply))
+
+	rebaseEvents := func(ctx sdk.Context) (events []sdk.Event) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeRebase {
+				events = append(events, event)
+			}
+		}
+		return
+	}
+
+	// no rebase before the period has elapsed
+	blockTime := minter.LastUpdate.Add(30 * time.Second)
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Equal(t, minter.InflationBase, app.MintKeeper.GetMinter(ctx).InflationBase)
+	require.Empty(t, rebaseEvents(ctx))
+
+	// the base is rebased on the supply before the provision of the block is minted
+	totalSupply := app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(params.MintDenom)
+	blockTime = blockTime.Add(30 * time.Second)
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	rebased := app.MintKeeper.GetMinter(ctx)
+	require.Equal(t, totalSupply, rebased.InflationBase)
+	require.Equal(t, blockTime, rebased.LastRebase)
+	events := rebaseEvents(ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, minter.InflationBase.String(), string(events[0].Attributes[0].Value))
+	require.Equal(t, totalSupply.String(), string(events[0].Attributes[1].Value))
+
+	provision := types.NewMinter(minter.LastUpdate.Add(30*time.Second), totalSupply).BlockProvision(params, blockTime)
+	require.Equal(t, totalSupply.Add(provision.Amount), app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(params.MintDenom))
+
+	// the next rebase is a period after the last one
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(blockTime.Add(30 * time.Second)).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, app.MintKeeper)
+	require.Empty(t, rebaseEvents(ctx))
+	require.Equal(t, rebased.InflationBase, app.MintKeeper.GetMinter(ctx).InflationBase)
+}
+
 // returns context and an app with updated mint keeper
+func TestBeginBlockerSupplyBaseline(t *testing.T) {
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockTime(types.DefaultMinter().LastUpdate.Add(5 * time.Second))
+	params := app.MintKeeper.GetParamSet(ctx)
+
+	// the baseline is recorded by the genesis or the upgrade, never while minting
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.SupplyBaselineKey)
+	supply := app.MintKeeper.GetSupply(ctx, params.MintDenom)
+
+	mint.BeginBlocker(ctx, app.MintKeeper)
+
+	_, found := app.MintKeeper.GetSupplyBaseline(ctx)
+	require.False(t, found)
+	require.True(t, app.MintKeeper.GetSupply(ctx, params.MintDenom).GT(supply))
+
+	_, broken := keeper.TotalMintedInvariant(app.MintKeeper)(ctx)
+	require.False(t, broken)
+}
+
 func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app := simapp.Setup(isCheckTx)
 
@@ -37,6 +340,18 @@ func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app.MintKeeper.SetParamSet(ctx, types.NewParams(
 		sdk.DefaultBondDenom,
 		sdk.NewDecWithPrec(4, 2),
+		types.ProvisionModeTime,
+		time.Minute,
+		nil,
+		types.InflationModeFixed,
+		sdk.NewDecWithPrec(13, 2),
+		sdk.NewDecWithPrec(20, 2),
+		sdk.NewDecWithPrec(7, 2),
+		sdk.NewDecWithPrec(67, 2),
+		types.DefaultMintRecipients(),
+		sdk.NewIntWithDecimal(1, 16),
+		0,
+		0,
 	))
 	app.MintKeeper.SetMinter(ctx, types.DefaultMinter())
 	app.BankKeeper.SetSupply(ctx, &banktypes.Supply{})
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
@@ -52,4 +53,71 @@ func (s *IntegrationTestSuite) TestMint() {
 	params := respType.(*minttypes.Params)
 	s.Require().Equal("stake", params.MintDenom)
 	s.Require().Equal("0.040000000000000000", params.Inflation.String())
+
+	//------test GetCmdQueryMinter()-------------
+	minterType := proto.Message(&minttypes.Minter{})
+	bz, err = minttestutil.QueryMinterExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), minterType))
+	minter := minterType.(*minttypes.Minter)
+	s.Require().True(minter.InflationBase.IsPositive())
+
+	//------test GetCmdQueryAnnualProvisions()-------------
+	decCoinType := proto.Message(&sdk.DecCoin{})
+	bz, err = minttestutil.QueryAnnualProvisionsExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), decCoinType))
+	s.Require().Equal(minter.NextAnnualProvisions(*params), decCoinType.(*sdk.DecCoin).Amount)
+
+	//------test GetCmdQueryBlockProvision()-------------
+	coinType := proto.Message(&sdk.Coin{})
+	bz, err = minttestutil.QueryBlockProvisionExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), coinType))
+	s.Require().Equal("stake", coinType.(*sdk.Coin).Denom)
+
+	//------test GetCmdQueryInflationSchedule()-------------
+	scheduleType := proto.Message(&minttypes.QueryInflationScheduleResponse{})
+	bz, err = minttestutil.QueryInflationScheduleExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), scheduleType))
+	s.Require().Empty(scheduleType.(*minttypes.QueryInflationScheduleResponse).Schedule)
+
+	//------test GetCmdQueryActiveInflationSegment()-------------
+	segmentType := proto.Message(&minttypes.ActiveInflationSegment{})
+	bz, err = minttestutil.QueryActiveInflationSegmentExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), segmentType))
+	activeSegment := segmentType.(*minttypes.ActiveInflationSegment)
+	s.Require().Equal(int64(-1), activeSegment.Index)
+	s.Require().Equal(params.Inflation, activeSegment.Inflation)
+
+	//------test GetCmdQuerySupplyHeadroom()-------------
+	headroomType := proto.Message(&minttypes.SupplyHeadroom{})
+	bz, err = minttestutil.QuerySupplyHeadroomExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), headroomType))
+	headroom := headroomType.(*minttypes.SupplyHeadroom)
+	s.Require().Equal(params.MaxSupply, headroom.MaxSupply.Amount)
+	s.Require().Equal(params.MaxSupply.Sub(headroom.Supply.Amount), headroom.Headroom.Amount)
+
+	//------test GetCmdQueryTotalMinted()-------------
+	totalMintedType := proto.Message(&minttypes.MintCheckpoint{})
+	bz, err = minttestutil.QueryTotalMintedExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), totalMintedType))
+	totalMinted := totalMintedType.(*minttypes.MintCheckpoint)
+	s.Require().True(totalMinted.Height > 0)
+	s.Require().True(totalMinted.TotalMinted.AmountOf(params.MintDenom).IsPositive())
+
+	//------test GetCmdQueryCheckpoints()-------------
+	checkpointsType := proto.Message(&minttypes.QueryCheckpointsResponse{})
+	bz, err = minttestutil.QueryCheckpointsExec(val.ClientCtx)
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(bz.Bytes(), checkpointsType))
+	s.Require().Empty(checkpointsType.(*minttypes.QueryCheckpointsResponse).Checkpoints)
+
+	//------test GetCmdQueryCheckpoint()-------------
+	_, err = minttestutil.QueryCheckpointExec(val.ClientCtx, "1")
+	s.Require().Error(err)
 }
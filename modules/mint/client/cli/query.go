@@ -2,11 +2,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -22,6 +25,15 @@ func GetQueryCmd() *cobra.Command {
 	}
 	mintingQueryCmd.AddCommand(
 		GetCmdQueryParams(),
+		GetCmdQueryMinter(),
+		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryBlockProvision(),
+		GetCmdQueryInflationSchedule(),
+		GetCmdQueryActiveInflationSegment(),
+		GetCmdQuerySupplyHeadroom(),
+		GetCmdQueryTotalMinted(),
+		GetCmdQueryCheckpoints(),
+		GetCmdQueryCheckpoint(),
 	)
 	return mintingQueryCmd
 }
@@ -51,3 +63,253 @@ func GetCmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryMinter implements a command to return the current minter state.
+func GetCmdQueryMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minter",
+		Short: "Query the current minter state",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Minter(context.Background(), &types.QueryMinterRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Minter)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAnnualProvisions implements a command to return the current annual provisions.
+func GetCmdQueryAnnualProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annual-provisions",
+		Short: "Query the current annual provisions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AnnualProvisions(context.Background(), &types.QueryAnnualProvisionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.AnnualProvisions)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBlockProvision implements a command to return the estimated provision of the next block.
+func GetCmdQueryBlockProvision() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-provision",
+		Short: "Query the estimated provision of the next block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockProvision(context.Background(), &types.QueryBlockProvisionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.BlockProvision)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryInflationSchedule implements a command to return the inflation schedule.
+func GetCmdQueryInflationSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-schedule",
+		Short: "Query the inflation schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InflationSchedule(context.Background(), &types.QueryInflationScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryActiveInflationSegment implements a command to return the segment of the inflation schedule in effect.
+func GetCmdQueryActiveInflationSegment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-inflation-segment",
+		Short: "Query the segment of the inflation schedule in effect",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ActiveInflationSegment(context.Background(), &types.QueryActiveInflationSegmentRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.ActiveSegment)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySupplyHeadroom implements a command to return the amount which can still be minted before the max supply is reached.
+func GetCmdQuerySupplyHeadroom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-headroom",
+		Short: "Query the amount which can still be minted before the max supply is reached",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SupplyHeadroom(context.Background(), &types.QuerySupplyHeadroomRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.SupplyHeadroom)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTotalMinted implements a command to return the total amount of coins minted so far.
+func GetCmdQueryTotalMinted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-minted",
+		Short: "Query the total amount of coins minted so far",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalMinted(context.Background(), &types.QueryTotalMintedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.TotalMinted)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCheckpoints implements a command to return all checkpoints of the total minted coins.
+func GetCmdQueryCheckpoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checkpoints",
+		Short:   "Query for all checkpoints of the total minted coins",
+		Example: fmt.Sprintf("%s query mint checkpoints", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Checkpoints(
+				context.Background(),
+				&types.QueryCheckpointsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all checkpoints")
+	return cmd
+}
+
+// GetCmdQueryCheckpoint implements a command to return the last checkpoint of the total minted coins at or before a height.
+func GetCmdQueryCheckpoint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checkpoint [height]",
+		Short:   "Query the last checkpoint of the total minted coins at or before a height",
+		Example: fmt.Sprintf("%s query mint checkpoint <height>", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Checkpoint(context.Background(), &types.QueryCheckpointRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Checkpoint)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
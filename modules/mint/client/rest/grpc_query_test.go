@@ -55,4 +55,38 @@ func (s *IntegrationTestSuite) TestHtlc() {
 	paramsResp := respType.(*minttypes.QueryParamsResponse)
 	s.Require().Equal("stake", paramsResp.Params.MintDenom)
 	s.Require().Equal("0.040000000000000000", paramsResp.Params.Inflation.String())
+
+	url = fmt.Sprintf("%s/irishub/mint/minter", baseURL)
+	resp, err = rest.GetRequest(url)
+	minterRespType := proto.Message(&minttypes.QueryMinterResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, minterRespType))
+	minter := minterRespType.(*minttypes.QueryMinterResponse).Minter
+	s.Require().True(minter.InflationBase.IsPositive())
+
+	url = fmt.Sprintf("%s/irishub/mint/annual_provisions", baseURL)
+	resp, err = rest.GetRequest(url)
+	provisionsRespType := proto.Message(&minttypes.QueryAnnualProvisionsResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, provisionsRespType))
+	s.Require().Equal(
+		minter.NextAnnualProvisions(paramsResp.Params),
+		provisionsRespType.(*minttypes.QueryAnnualProvisionsResponse).AnnualProvisions.Amount,
+	)
+
+	url = fmt.Sprintf("%s/irishub/mint/block_provision", baseURL)
+	resp, err = rest.GetRequest(url)
+	provisionRespType := proto.Message(&minttypes.QueryBlockProvisionResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, provisionRespType))
+	s.Require().Equal("stake", provisionRespType.(*minttypes.QueryBlockProvisionResponse).BlockProvision.Denom)
+
+	url = fmt.Sprintf("%s/irishub/mint/supply_headroom", baseURL)
+	resp, err = rest.GetRequest(url)
+	headroomRespType := proto.Message(&minttypes.QuerySupplyHeadroomResponse{})
+	s.Require().NoError(err)
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, headroomRespType))
+	headroom := headroomRespType.(*minttypes.QuerySupplyHeadroomResponse).SupplyHeadroom
+	s.Require().Equal(paramsResp.Params.MaxSupply, headroom.MaxSupply.Amount)
+	s.Require().True(headroom.Headroom.IsPositive())
 }
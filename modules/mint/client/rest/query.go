@@ -15,6 +15,18 @@ import (
 func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 	// get the current mint parameter values
 	r.HandleFunc(fmt.Sprintf("/%s/params", types.ModuleName), queryParamsHandlerFn(cliCtx)).Methods("GET")
+	// get the current minter state
+	r.HandleFunc(fmt.Sprintf("/%s/minter", types.ModuleName), queryHandlerFn(cliCtx, types.QueryMinter)).Methods("GET")
+	// get the current annual provisions
+	r.HandleFunc(fmt.Sprintf("/%s/annual-provisions", types.ModuleName), queryHandlerFn(cliCtx, types.QueryAnnualProvisions)).Methods("GET")
+	// get the provision of the next block
+	r.HandleFunc(fmt.Sprintf("/%s/block-provision", types.ModuleName), queryHandlerFn(cliCtx, types.QueryBlockProvision)).Methods("GET")
+	// get the inflation schedule
+	r.HandleFunc(fmt.Sprintf("/%s/inflation-schedule", types.ModuleName), queryHandlerFn(cliCtx, types.QueryInflationSchedule)).Methods("GET")
+	// get the segment of the inflation schedule in effect
+	r.HandleFunc(fmt.Sprintf("/%s/inflation-schedule/active", types.ModuleName), queryHandlerFn(cliCtx, types.QueryActiveInflationSegment)).Methods("GET")
+	// get the amount which can still be minted before the max supply is reached
+	r.HandleFunc(fmt.Sprintf("/%s/supply-headroom", types.ModuleName), queryHandlerFn(cliCtx, types.QuerySupplyHeadroom)).Methods("GET")
 }
 
 // HTTP request handler to get the current mint parameter values
@@ -37,3 +49,24 @@ func queryParamsHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the given mint querier endpoint which takes no params
+func queryHandlerFn(cliCtx client.Context, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -20,3 +20,85 @@ func QueryParamsExec(clientCtx client.Context, extraArgs ...string) (testutil.Bu
 
 	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryParams(), args)
 }
+
+func QueryMinterExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryMinter(), args)
+}
+
+func QueryAnnualProvisionsExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryAnnualProvisions(), args)
+}
+
+func QueryBlockProvisionExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryBlockProvision(), args)
+}
+
+func QueryInflationScheduleExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryInflationSchedule(), args)
+}
+
+func QueryActiveInflationSegmentExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryActiveInflationSegment(), args)
+}
+
+func QuerySupplyHeadroomExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQuerySupplyHeadroom(), args)
+}
+
+func QueryTotalMintedExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryTotalMinted(), args)
+}
+
+func QueryCheckpointsExec(clientCtx client.Context, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryCheckpoints(), args)
+}
+
+func QueryCheckpointExec(clientCtx client.Context, height string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		height,
+		fmt.Sprintf("--%s=json", cli.OutputFlag),
+	}
+	args = append(args, extraArgs...)
+
+	return clitestutil.ExecTestCLICmd(clientCtx, mintcli.GetCmdQueryCheckpoint(), args)
+}
@@ -17,13 +17,20 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	}
 	keeper.SetMinter(ctx, data.Minter)
 	keeper.SetParamSet(ctx, data.Params)
+	keeper.SetTotalMinted(ctx, data.TotalMinted)
+	for _, checkpoint := range data.Checkpoints {
+		keeper.SetCheckpoint(ctx, checkpoint)
+	}
+	keeper.SetSupplyBaseline(ctx, data.Params.MintDenom, data.TotalMinted.TotalMinted)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParamSet(ctx)
-	return types.NewGenesisState(minter, params)
+	totalMinted := keeper.GetTotalMinted(ctx)
+	checkpoints := keeper.GetCheckpoints(ctx)
+	return types.NewGenesisState(minter, params, totalMinted, checkpoints)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
@@ -32,5 +39,8 @@ func ValidateGenesis(data types.GenesisState) error {
 	if !data.Minter.InflationBase.IsPositive() {
 		return errors.New("base inflation must be positive")
 	}
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	return types.ValidateCheckpoints(data.Checkpoints, data.TotalMinted)
 }
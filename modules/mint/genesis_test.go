@@ -40,3 +40,27 @@ func (suite *TestSuite) TestExportGenesis() {
 	exportedGenesis := mint.ExportGenesis(suite.ctx, suite.app.MintKeeper)
 	suite.Equal(defaultGenesis, exportedGenesis)
 }
+
+func (suite *TestSuite) TestExportImportCheckpoints() {
+	app, ctx := suite.app, suite.ctx
+	params := app.MintKeeper.GetParamSet(ctx)
+	params.CheckpointInterval = 10
+	for height := int64(5); height <= 25; height += 5 {
+		app.MintKeeper.RecordMintedCoins(ctx.WithBlockHeight(height), params, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100)))
+	}
+
+	exportedGenesis := mint.ExportGenesis(ctx, app.MintKeeper)
+	suite.Equal(int64(25), exportedGenesis.TotalMinted.Height)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 500)), exportedGenesis.TotalMinted.TotalMinted)
+	suite.Len(exportedGenesis.Checkpoints, 2)
+	suite.NoError(mint.ValidateGenesis(*exportedGenesis))
+
+	newApp := simapp.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{})
+	mint.InitGenesis(newCtx, newApp.MintKeeper, *exportedGenesis)
+	suite.Equal(exportedGenesis, mint.ExportGenesis(newCtx, newApp.MintKeeper))
+
+	// checkpoints out of order are rejected
+	exportedGenesis.Checkpoints[0], exportedGenesis.Checkpoints[1] = exportedGenesis.Checkpoints[1], exportedGenesis.Checkpoints[0]
+	suite.Error(mint.ValidateGenesis(*exportedGenesis))
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// GetTotalMinted returns the running total of the minted coins
+func (k Keeper) GetTotalMinted(ctx sdk.Context) types.MintCheckpoint {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalMintedKey)
+	if bz == nil {
+		return types.DefaultTotalMinted()
+	}
+
+	var totalMinted types.MintCheckpoint
+	k.cdc.MustUnmarshalBinaryBare(bz, &totalMinted)
+	return totalMinted
+}
+
+// SetTotalMinted sets the running total of the minted coins
+func (k Keeper) SetTotalMinted(ctx sdk.Context, totalMinted types.MintCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&totalMinted)
+	store.Set(types.TotalMintedKey, bz)
+}
+
+// RecordMintedCoins adds the coins minted in the current block to the running total, and
+// stores a checkpoint of the total every CheckpointInterval blocks
+func (k Keeper) RecordMintedCoins(ctx sdk.Context, params types.Params, coins sdk.Coins) types.MintCheckpoint {
+	totalMinted := k.GetTotalMinted(ctx)
+	totalMinted = types.NewMintCheckpoint(ctx.BlockHeight(), ctx.BlockTime(), totalMinted.TotalMinted.Add(coins...))
+	k.SetTotalMinted(ctx, totalMinted)
+
+	if params.CheckpointInterval > 0 && uint64(ctx.BlockHeight())%params.CheckpointInterval == 0 {
+		k.SetCheckpoint(ctx, totalMinted)
+	}
+	return totalMinted
+}
+
+// SetCheckpoint stores the checkpoint of the total minted coins
+func (k Keeper) SetCheckpoint(ctx sdk.Context, checkpoint types.MintCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&checkpoint)
+	store.Set(types.GetCheckpointKey(checkpoint.Height), bz)
+}
+
+// GetCheckpoint returns the last checkpoint of the total minted coins at or before the given height
+func (k Keeper) GetCheckpoint(ctx sdk.Context, height int64) (checkpoint types.MintCheckpoint, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.ReverseIterator(types.CheckpointKey, sdk.PrefixEndBytes(types.GetCheckpointKey(height)))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return checkpoint, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &checkpoint)
+	return checkpoint, true
+}
+
+// IterateCheckpoints iterates through the checkpoints of the total minted coins in ascending height
+func (k Keeper) IterateCheckpoints(
+	ctx sdk.Context,
+	op func(checkpoint types.MintCheckpoint) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.CheckpointKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var checkpoint types.MintCheckpoint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &checkpoint)
+
+		if stop := op(checkpoint); stop {
+			break
+		}
+	}
+}
+
+// GetCheckpoints returns all checkpoints of the total minted coins in ascending height
+func (k Keeper) GetCheckpoints(ctx sdk.Context) (checkpoints []types.MintCheckpoint) {
+	k.IterateCheckpoints(
+		ctx,
+		func(checkpoint types.MintCheckpoint) bool {
+			checkpoints = append(checkpoints, checkpoint)
+			return false
+		},
+	)
+	return
+}
+
+// GetSupplyBaseline returns the supply and the total minted coins at genesis, or at the upgrade
+// of a chain started before the baseline was introduced
+func (k Keeper) GetSupplyBaseline(ctx sdk.Context) (baseline types.SupplyBaseline, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SupplyBaselineKey)
+	if bz == nil {
+		return baseline, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &baseline)
+	return baseline, true
+}
+
+// SetSupplyBaseline records the current supply of the mint denom and of the minted denoms
+// along with the given total minted coins, against which the supply delta is checked
+func (k Keeper) SetSupplyBaseline(ctx sdk.Context, mintDenom string, totalMinted sdk.Coins) {
+	supply := sdk.NewCoins(sdk.NewCoin(mintDenom, k.GetSupply(ctx, mintDenom)))
+	for _, coin := range totalMinted {
+		if coin.Denom != mintDenom {
+			supply = supply.Add(sdk.NewCoin(coin.Denom, k.GetSupply(ctx, coin.Denom)))
+		}
+	}
+
+	baseline := types.SupplyBaseline{Supply: supply, TotalMinted: totalMinted}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SupplyBaselineKey, k.cdc.MustMarshalBinaryBare(&baseline))
+}
+
+// EnsureSupplyBaseline records the supply baseline against the current total minted coins,
+// unless a baseline has already been recorded
+func (k Keeper) EnsureSupplyBaseline(ctx sdk.Context) {
+	if _, found := k.GetSupplyBaseline(ctx); found {
+		return
+	}
+	k.SetSupplyBaseline(ctx, k.GetParamSet(ctx).MintDenom, k.GetTotalMinted(ctx).TotalMinted)
+}
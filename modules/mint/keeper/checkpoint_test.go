@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/irisnet/irishub/modules/mint/keeper"
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordMintedCoins() {
+	app := suite.app
+	params := app.MintKeeper.GetParamSet(suite.ctx)
+	params.CheckpointInterval = 10
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.Equal(types.DefaultTotalMinted(), app.MintKeeper.GetTotalMinted(suite.ctx))
+
+	// mint 100 coins per block up to height 25
+	for height := int64(1); height <= 25; height++ {
+		ctx := suite.ctx.WithBlockHeight(height).WithBlockTime(blockTime.Add(time.Duration(height) * 5 * time.Second))
+		app.MintKeeper.RecordMintedCoins(ctx, params, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100)))
+	}
+
+	totalMinted := app.MintKeeper.GetTotalMinted(suite.ctx)
+	suite.Equal(int64(25), totalMinted.Height)
+	suite.Equal(blockTime.Add(125*time.Second), totalMinted.Time)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 2500)), totalMinted.TotalMinted)
+
+	checkpoints := app.MintKeeper.GetCheckpoints(suite.ctx)
+	suite.Len(checkpoints, 2)
+	suite.Equal(int64(10), checkpoints[0].Height)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)), checkpoints[0].TotalMinted)
+	suite.Equal(int64(20), checkpoints[1].Height)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 2000)), checkpoints[1].TotalMinted)
+	suite.NoError(types.ValidateCheckpoints(checkpoints, totalMinted))
+
+	// the last checkpoint at or before a height is returned
+	_, found := app.MintKeeper.GetCheckpoint(suite.ctx, 9)
+	suite.False(found)
+	checkpoint, found := app.MintKeeper.GetCheckpoint(suite.ctx, 10)
+	suite.True(found)
+	suite.Equal(checkpoints[0], checkpoint)
+	checkpoint, found = app.MintKeeper.GetCheckpoint(suite.ctx, 19)
+	suite.True(found)
+	suite.Equal(checkpoints[0], checkpoint)
+	checkpoint, found = app.MintKeeper.GetCheckpoint(suite.ctx, 100)
+	suite.True(found)
+	suite.Equal(checkpoints[1], checkpoint)
+
+	// no checkpoint is stored once they are disabled
+	params.CheckpointInterval = 0
+	ctx := suite.ctx.WithBlockHeight(30).WithBlockTime(blockTime.Add(150 * time.Second))
+	app.MintKeeper.RecordMintedCoins(ctx, params, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100)))
+	suite.Len(app.MintKeeper.GetCheckpoints(suite.ctx), 2)
+	suite.Equal(int64(30), app.MintKeeper.GetTotalMinted(suite.ctx).Height)
+}
+
+func (suite *KeeperTestSuite) TestTotalMintedInvariant() {
+	app := suite.app
+	params := app.MintKeeper.GetParamSet(suite.ctx)
+	invariant := keeper.TotalMintedInvariant(app.MintKeeper)
+
+	// the invariant holds trivially until a baseline is recorded, as on a chain upgraded
+	// from a version without the supply baseline
+	suite.ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.SupplyBaselineKey)
+	_, found := app.MintKeeper.GetSupplyBaseline(suite.ctx)
+	suite.False(found)
+	_, broken := invariant(suite.ctx)
+	suite.False(broken)
+
+	app.MintKeeper.SetSupplyBaseline(suite.ctx, params.MintDenom, nil)
+	_, broken = invariant(suite.ctx)
+	suite.False(broken)
+
+	// recorded minting keeps the invariant
+	mintedCoins := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000))
+	suite.NoError(app.MintKeeper.MintCoins(suite.ctx, mintedCoins))
+	app.MintKeeper.RecordMintedCoins(suite.ctx.WithBlockHeight(1), params, mintedCoins)
+	_, broken = invariant(suite.ctx)
+	suite.False(broken)
+
+	// burning keeps the invariant
+	burnedCoins := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 500))
+	suite.NoError(app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, govtypes.ModuleName, burnedCoins))
+	suite.NoError(app.BankKeeper.BurnCoins(suite.ctx, govtypes.ModuleName, burnedCoins))
+	_, broken = invariant(suite.ctx)
+	suite.False(broken)
+
+	// unrecorded minting breaks the invariant
+	suite.NoError(app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000))))
+	_, broken = invariant(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *KeeperTestSuite) TestEnsureSupplyBaseline() {
+	app := suite.app
+	params := app.MintKeeper.GetParamSet(suite.ctx)
+	suite.ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.SupplyBaselineKey)
+
+	totalMinted := types.NewMintCheckpoint(1, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000)))
+	app.MintKeeper.SetTotalMinted(suite.ctx, totalMinted)
+	supply := app.MintKeeper.GetSupply(suite.ctx, params.MintDenom)
+
+	app.MintKeeper.EnsureSupplyBaseline(suite.ctx)
+	baseline, found := app.MintKeeper.GetSupplyBaseline(suite.ctx)
+	suite.True(found)
+	suite.Equal(supply, baseline.Supply.AmountOf(params.MintDenom))
+	suite.Equal(totalMinted.TotalMinted, baseline.TotalMinted)
+
+	// an existing baseline is kept
+	suite.NoError(app.MintKeeper.MintCoins(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000))))
+	app.MintKeeper.EnsureSupplyBaseline(suite.ctx)
+	kept, found := app.MintKeeper.GetSupplyBaseline(suite.ctx)
+	suite.True(found)
+	suite.Equal(baseline, kept)
+	_, broken := keeper.TotalMintedInvariant(app.MintKeeper)(suite.ctx)
+	suite.True(broken)
+}
@@ -3,7 +3,12 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -17,3 +22,99 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// Minter queries the minter state
+func (k Keeper) Minter(c context.Context, _ *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+
+	return &types.QueryMinterResponse{Minter: minter}, nil
+}
+
+// AnnualProvisions queries the current annual provisions
+func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisionsRequest) (*types.QueryAnnualProvisionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	provisions := k.GetAnnualProvisions(ctx)
+
+	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: provisions}, nil
+}
+
+// BlockProvision queries the provision of the next block
+func (k Keeper) BlockProvision(c context.Context, _ *types.QueryBlockProvisionRequest) (*types.QueryBlockProvisionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	provision := k.GetNextBlockProvision(ctx)
+
+	return &types.QueryBlockProvisionResponse{BlockProvision: provision}, nil
+}
+
+// InflationSchedule queries the inflation schedule
+func (k Keeper) InflationSchedule(c context.Context, _ *types.QueryInflationScheduleRequest) (*types.QueryInflationScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule := k.GetParamSet(ctx).InflationSchedule
+
+	return &types.QueryInflationScheduleResponse{Schedule: schedule}, nil
+}
+
+// ActiveInflationSegment queries the segment of the inflation schedule in effect
+func (k Keeper) ActiveInflationSegment(c context.Context, _ *types.QueryActiveInflationSegmentRequest) (*types.QueryActiveInflationSegmentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	activeSegment := k.GetActiveInflationSegment(ctx)
+
+	return &types.QueryActiveInflationSegmentResponse{ActiveSegment: activeSegment}, nil
+}
+
+// SupplyHeadroom queries the amount which can still be minted before the max supply is reached
+func (k Keeper) SupplyHeadroom(c context.Context, _ *types.QuerySupplyHeadroomRequest) (*types.QuerySupplyHeadroomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	headroom := k.GetSupplyHeadroom(ctx)
+
+	return &types.QuerySupplyHeadroomResponse{SupplyHeadroom: headroom}, nil
+}
+
+// TotalMinted queries the total amount of coins minted so far
+func (k Keeper) TotalMinted(c context.Context, _ *types.QueryTotalMintedRequest) (*types.QueryTotalMintedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	totalMinted := k.GetTotalMinted(ctx)
+
+	return &types.QueryTotalMintedResponse{TotalMinted: totalMinted}, nil
+}
+
+// Checkpoints queries all checkpoints of the total minted coins
+func (k Keeper) Checkpoints(c context.Context, req *types.QueryCheckpointsRequest) (*types.QueryCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var checkpoints []types.MintCheckpoint
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CheckpointKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var checkpoint types.MintCheckpoint
+		k.cdc.MustUnmarshalBinaryBare(value, &checkpoint)
+		checkpoints = append(checkpoints, checkpoint)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryCheckpointsResponse{Checkpoints: checkpoints, Pagination: pageRes}, nil
+}
+
+// Checkpoint queries the last checkpoint of the total minted coins at or before a height
+func (k Keeper) Checkpoint(c context.Context, req *types.QueryCheckpointRequest) (*types.QueryCheckpointResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height: %d", req.Height)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	checkpoint, found := k.GetCheckpoint(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no checkpoint at or before height %d", req.Height)
+	}
+
+	return &types.QueryCheckpointResponse{Checkpoint: checkpoint}, nil
+}
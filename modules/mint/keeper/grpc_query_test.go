@@ -4,6 +4,8 @@ import (
 	gocontext "context"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -19,4 +21,93 @@ func (suite *KeeperTestSuite) TestGRPCQueryPoolParameters() {
 	resp, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
 	suite.NoError(err)
 	suite.Equal(app.MintKeeper.GetParamSet(ctx), resp.Params)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	params := app.MintKeeper.GetParamSet(ctx)
+
+	// Query Minter
+	minterResp, err := queryClient.Minter(gocontext.Background(), &types.QueryMinterRequest{})
+	suite.NoError(err)
+	suite.Equal(minter, minterResp.Minter)
+
+	// Query AnnualProvisions
+	provisionsResp, err := queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
+	suite.NoError(err)
+	suite.Equal(params.MintDenom, provisionsResp.AnnualProvisions.Denom)
+	suite.Equal(minter.NextAnnualProvisions(params), provisionsResp.AnnualProvisions.Amount)
+
+	// Query BlockProvision
+	provisionResp, err := queryClient.BlockProvision(gocontext.Background(), &types.QueryBlockProvisionRequest{})
+	suite.NoError(err)
+	suite.Equal(minter.NextBlockProvision(params), provisionResp.BlockProvision)
+	suite.True(provisionResp.BlockProvision.IsPositive())
+
+	params.InflationSchedule = []types.InflationSegment{
+		types.NewTimeInflationSegment(ctx.BlockTime(), sdk.NewDecWithPrec(8, 2)),
+		types.NewTimeInflationSegment(minter.LastUpdate.Add(types.ExpectedBlockInterval), sdk.NewDecWithPrec(1, 2)),
+	}
+	app.MintKeeper.SetParamSet(ctx, params)
+
+	// Query InflationSchedule
+	scheduleResp, err := queryClient.InflationSchedule(gocontext.Background(), &types.QueryInflationScheduleRequest{})
+	suite.NoError(err)
+	suite.Equal(params.InflationSchedule, scheduleResp.Schedule)
+
+	// Query ActiveInflationSegment
+	segmentResp, err := queryClient.ActiveInflationSegment(gocontext.Background(), &types.QueryActiveInflationSegmentRequest{})
+	suite.NoError(err)
+	suite.Equal(int64(0), segmentResp.ActiveSegment.Index)
+	suite.Equal(params.InflationSchedule[0], *segmentResp.ActiveSegment.Segment)
+	suite.Equal(sdk.NewDecWithPrec(8, 2), segmentResp.ActiveSegment.Inflation)
+
+	// the provisions follow the schedule
+	provisionsResp, err = queryClient.AnnualProvisions(gocontext.Background(), &types.QueryAnnualProvisionsRequest{})
+	suite.NoError(err)
+	suite.Equal(sdk.NewDecWithPrec(8, 2).MulInt(minter.InflationBase), provisionsResp.AnnualProvisions.Amount)
+	// the next block starts the second segment
+	provisionResp, err = queryClient.BlockProvision(gocontext.Background(), &types.QueryBlockProvisionRequest{})
+	suite.NoError(err)
+	params.Inflation = sdk.NewDecWithPrec(1, 2)
+	suite.Equal(minter.NextBlockProvision(params), provisionResp.BlockProvision)
+
+	// Query SupplyHeadroom
+	supply := app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(params.MintDenom)
+	headroomResp, err := queryClient.SupplyHeadroom(gocontext.Background(), &types.QuerySupplyHeadroomRequest{})
+	suite.NoError(err)
+	suite.Equal(types.NewSupplyHeadroom(params.MintDenom, supply, params.MaxSupply), headroomResp.SupplyHeadroom)
+	suite.Equal(params.MaxSupply.Sub(supply), headroomResp.SupplyHeadroom.Headroom.Amount)
+
+	// the provision of the next block is limited by the headroom
+	params.MaxSupply = supply.AddRaw(10)
+	app.MintKeeper.SetParamSet(ctx, params)
+	provisionResp, err = queryClient.BlockProvision(gocontext.Background(), &types.QueryBlockProvisionRequest{})
+	suite.NoError(err)
+	suite.Equal(sdk.NewInt64Coin(params.MintDenom, 10), provisionResp.BlockProvision)
+
+	// Query TotalMinted
+	params.CheckpointInterval = 10
+	for height := int64(10); height <= 30; height += 10 {
+		app.MintKeeper.RecordMintedCoins(ctx.WithBlockHeight(height), params, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100)))
+	}
+	totalMintedResp, err := queryClient.TotalMinted(gocontext.Background(), &types.QueryTotalMintedRequest{})
+	suite.NoError(err)
+	suite.Equal(app.MintKeeper.GetTotalMinted(ctx), totalMintedResp.TotalMinted)
+	suite.Equal(int64(30), totalMintedResp.TotalMinted.Height)
+
+	// Query Checkpoints
+	checkpointsResp, err := queryClient.Checkpoints(gocontext.Background(), &types.QueryCheckpointsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.NoError(err)
+	suite.Len(checkpointsResp.Checkpoints, 2)
+	suite.Equal(uint64(3), checkpointsResp.Pagination.Total)
+	suite.Equal(int64(10), checkpointsResp.Checkpoints[0].Height)
+	suite.Equal(int64(20), checkpointsResp.Checkpoints[1].Height)
+
+	// Query Checkpoint
+	checkpointResp, err := queryClient.Checkpoint(gocontext.Background(), &types.QueryCheckpointRequest{Height: 25})
+	suite.NoError(err)
+	suite.Equal(checkpointsResp.Checkpoints[1], checkpointResp.Checkpoint)
+	_, err = queryClient.Checkpoint(gocontext.Background(), &types.QueryCheckpointRequest{Height: 5})
+	suite.Error(err)
 }
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// RegisterInvariants registers all mint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-minted", TotalMintedInvariant(k))
+}
+
+// AllInvariants runs all invariants of the mint module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TotalMintedInvariant(k)(ctx)
+	}
+}
+
+// TotalMintedInvariant checks that the supply of every minted denom has not grown since the
+// supply baseline by more than the running total of the minted coins. The supply may grow by
+// less, as coins can be burned by other modules. Nothing is checked until a baseline is recorded
+func TotalMintedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		baseline, found := k.GetSupplyBaseline(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "total-minted", "no supply baseline recorded\n"), false
+		}
+		totalMinted := k.GetTotalMinted(ctx).TotalMinted
+
+		var msg string
+		count := 0
+		for _, coin := range baseline.Supply.Add(totalMinted...) {
+			supplyDelta := k.GetSupply(ctx, coin.Denom).Sub(baseline.Supply.AmountOf(coin.Denom))
+			mintedDelta := totalMinted.AmountOf(coin.Denom).Sub(baseline.TotalMinted.AmountOf(coin.Denom))
+			if supplyDelta.GT(mintedDelta) {
+				count++
+				msg += fmt.Sprintf("\tsupply of %s grew by %s while %s was minted\n", coin.Denom, supplyDelta, mintedDelta)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total-minted",
+			fmt.Sprintf("%d denoms with a supply delta exceeding the minted coins found\n%s", count, msg),
+		), broken
+	}
+}
@@ -2,11 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/mint/types"
@@ -17,14 +19,17 @@ type Keeper struct {
 	cdc              codec.Marshaler
 	storeKey         sdk.StoreKey
 	paramSpace       paramtypes.Subspace
+	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	stakingKeeper    types.StakingKeeper
+	distrKeeper      types.DistrKeeper
 	feeCollectorName string
 }
 
 // NewKeeper returns a mint keeper
 func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey,
 	paramSpace paramtypes.Subspace, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string) Keeper {
+	sk types.StakingKeeper, dk types.DistrKeeper, feeCollectorName string) Keeper {
 
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -35,7 +40,10 @@ func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey,
 		storeKey:         key,
 		cdc:              cdc,
 		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		accountKeeper:    ak,
 		bankKeeper:       bk,
+		stakingKeeper:    sk,
+		distrKeeper:      dk,
 		feeCollectorName: feeCollectorName,
 	}
 	return keeper
@@ -82,6 +90,79 @@ func (k Keeper) AddCollectedFees(ctx sdk.Context, coins sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coins)
 }
 
+// DistributeMintedCoin sends the shares of the minted coin to the recipients defined by the
+// params, emitting an event per transfer. A share which can not be sent to its recipient,
+// e.g. an unregistered module or a blocked address, is sent to the fee collector instead
+func (k Keeper) DistributeMintedCoin(ctx sdk.Context, params types.Params, coin sdk.Coin) error {
+	recipients := params.MintRecipients()
+	for i, share := range types.SplitMintedCoin(recipients, coin) {
+		if !share.IsPositive() {
+			continue
+		}
+
+		recipient := recipients[i]
+		cacheCtx, writeCache := ctx.CacheContext()
+		address, err := k.sendToRecipient(cacheCtx, recipient, sdk.NewCoins(share))
+		if err != nil {
+			k.Logger(ctx).Error("failed to send minted coins, falling back to the fee collector",
+				"recipient_type", recipient.Type.String(), "recipient", recipient.Address, "amount", share.String(), "err", err.Error())
+
+			recipient = types.NewMintRecipient(types.RecipientFeeCollector, "", recipient.Weight)
+			if address, err = k.sendToRecipient(ctx, recipient, sdk.NewCoins(share)); err != nil {
+				return err
+			}
+		} else {
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintTransfer,
+				sdk.NewAttribute(types.AttributeKeyRecipientType, recipient.Type.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, address.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// sendToRecipient sends the coins from the mint module account to the recipient and returns
+// the address which received them
+func (k Keeper) sendToRecipient(ctx sdk.Context, recipient types.MintRecipient, coins sdk.Coins) (sdk.AccAddress, error) {
+	switch recipient.Type {
+	case types.RecipientFeeCollector:
+		return k.accountKeeper.GetModuleAddress(k.feeCollectorName), k.AddCollectedFees(ctx, coins)
+	case types.RecipientCommunityPool:
+		sender := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		return k.accountKeeper.GetModuleAddress(distrtypes.ModuleName), k.distrKeeper.FundCommunityPool(ctx, coins, sender)
+	case types.RecipientModule:
+		address := k.accountKeeper.GetModuleAddress(recipient.Address)
+		if address == nil {
+			return nil, fmt.Errorf("module account %s does not exist", recipient.Address)
+		}
+		return address, k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Address, coins)
+	case types.RecipientAccount:
+		address, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return nil, err
+		}
+		if k.bankKeeper.BlockedAddr(address) {
+			return nil, fmt.Errorf("%s is not allowed to receive funds", recipient.Address)
+		}
+		return address, k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins)
+	default:
+		return nil, fmt.Errorf("invalid recipient type: %d", recipient.Type)
+	}
+}
+
+// BondedRatio implements an alias call to the underlying staking keeper's
+// BondedRatio to be used in BeginBlocker.
+func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
+	return k.stakingKeeper.BondedRatio(ctx)
+}
+
 // GetParamSet returns inflation params from the global param store
 func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -93,3 +174,51 @@ func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 func (k Keeper) SetParamSet(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
+
+// GetSupply returns the total supply of the given denom
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) sdk.Int {
+	return k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(denom)
+}
+
+// GetSupplyHeadroom returns the amount of the mint denom which can still be minted before
+// the total supply reaches the max supply
+func (k Keeper) GetSupplyHeadroom(ctx sdk.Context) types.SupplyHeadroom {
+	params := k.GetParamSet(ctx)
+	return types.NewSupplyHeadroom(params.MintDenom, k.GetSupply(ctx, params.MintDenom), params.MaxSupply)
+}
+
+// GetActiveInflationSegment returns the segment of the inflation schedule in effect at the current block
+func (k Keeper) GetActiveInflationSegment(ctx sdk.Context) types.ActiveInflationSegment {
+	return k.GetParamSet(ctx).ActiveInflationSegment(ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// GetBlockParams returns the params with the inflation rate in effect for a block at the given
+// height and time. In the dynamic inflation mode the rate of the minter is moved toward the
+// target bonded ratio, otherwise the inflation schedule applies
+func (k Keeper) GetBlockParams(ctx sdk.Context, minter types.Minter, height int64, blockTime time.Time) types.Params {
+	params := k.GetParamSet(ctx).WithScheduledInflation(height, blockTime)
+	if params.InflationMode == types.InflationModeDynamic {
+		params.Inflation = minter.NextInflationRate(params, k.BondedRatio(ctx), blockTime)
+	}
+	return params
+}
+
+// GetAnnualProvisions returns the annual provisions at the inflation rate in effect at the current block
+func (k Keeper) GetAnnualProvisions(ctx sdk.Context) sdk.DecCoin {
+	minter := k.GetMinter(ctx)
+	params := k.GetParamSet(ctx).WithScheduledInflation(ctx.BlockHeight(), ctx.BlockTime())
+	if params.InflationMode == types.InflationModeDynamic {
+		params.Inflation = minter.Inflation
+	}
+	return sdk.NewDecCoinFromDec(params.MintDenom, minter.NextAnnualProvisions(params))
+}
+
+// GetNextBlockProvision estimates the provision of the next block, which is assumed to be
+// committed types.ExpectedBlockInterval after the last update, limited by the supply headroom
+func (k Keeper) GetNextBlockProvision(ctx sdk.Context) sdk.Coin {
+	minter := k.GetMinter(ctx)
+	nextBlockTime := minter.LastUpdate.Add(types.ExpectedBlockInterval)
+	params := k.GetBlockParams(ctx, minter, ctx.BlockHeight()+1, nextBlockTime)
+	provision, _ := k.GetSupplyHeadroom(ctx).CapProvision(minter.NextBlockProvision(params))
+	return provision
+}
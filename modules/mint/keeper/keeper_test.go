@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 	"github.com/irisnet/irishub/simapp"
@@ -55,6 +56,39 @@ func (suite *KeeperTestSuite) TestSetGetParamSet() {
 	require.Equal(suite.T(), types.DefaultParams(), expParamSet)
 }
 
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	params := types.DefaultParams()
+	params.Inflation = sdk.NewDecWithPrec(5, 2)
+	params.ProvisionMode = types.ProvisionModeBlock
+	suite.app.MintKeeper.SetParamSet(suite.ctx, params)
+
+	// a running chain only stores the inflation and the mint denom
+	store := suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey))
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) != string(types.KeyInflation) && string(pair.Key) != string(types.KeyMintDenom) {
+			store.Delete(append([]byte(types.DefaultParamSpace+"/"), pair.Key...))
+		}
+	}
+	lastUpdate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.app.MintKeeper.SetMinter(suite.ctx, types.Minter{LastUpdate: lastUpdate, InflationBase: sdk.NewInt(100000)})
+	suite.Panics(func() { suite.app.MintKeeper.GetParamSet(suite.ctx) })
+
+	suite.app.MintKeeper.MigrateParams(suite.ctx)
+
+	expParams := types.DefaultParams()
+	expParams.Inflation = params.Inflation
+	expParams.ProvisionMode = types.ProvisionModeBlock
+	suite.Equal(expParams, suite.app.MintKeeper.GetParamSet(suite.ctx))
+
+	minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+	suite.Equal(params.Inflation, minter.Inflation)
+	suite.Equal(lastUpdate, minter.LastRebase)
+
+	// the migration keeps the params already stored
+	suite.app.MintKeeper.MigrateParams(suite.ctx)
+	suite.Equal(expParams, suite.app.MintKeeper.GetParamSet(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestMintCoins() {
 	suite.app.BankKeeper.SetSupply(suite.ctx, &banktypes.Supply{})
 
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irisnet/irishub/modules/mint/types"
+)
+
+// MigrateParams sets the params which are missing from the store of a running chain to their
+// defaults, and fills the minter fields added along with them
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	// a running chain keeps provisioning per block, switching to block time takes a param change proposal
+	params.ProvisionMode = types.ProvisionModeBlock
+	for _, pair := range params.ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	// the minter of a running chain has neither an inflation nor a last rebase time
+	minter := k.GetMinter(ctx)
+	if minter.Inflation.IsNil() || minter.Inflation.IsZero() {
+		minter.Inflation = k.GetParamSet(ctx).Inflation
+	}
+	if minter.LastRebase.IsZero() {
+		minter.LastRebase = minter.LastUpdate
+	}
+	k.SetMinter(ctx, minter)
+}
@@ -16,6 +16,18 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 		switch path[0] {
 		case types.QueryParameters:
 			return queryParams(ctx, k, legacyQuerierCdc)
+		case types.QueryMinter:
+			return queryMinter(ctx, k, legacyQuerierCdc)
+		case types.QueryAnnualProvisions:
+			return queryAnnualProvisions(ctx, k, legacyQuerierCdc)
+		case types.QueryBlockProvision:
+			return queryBlockProvision(ctx, k, legacyQuerierCdc)
+		case types.QueryInflationSchedule:
+			return queryInflationSchedule(ctx, k, legacyQuerierCdc)
+		case types.QueryActiveInflationSegment:
+			return queryActiveInflationSegment(ctx, k, legacyQuerierCdc)
+		case types.QuerySupplyHeadroom:
+			return querySupplyHeadroom(ctx, k, legacyQuerierCdc)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -32,3 +44,69 @@ func queryParams(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino)
 
 	return res, nil
 }
+
+func queryMinter(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	minter := k.GetMinter(ctx)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, minter)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAnnualProvisions(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	provisions := k.GetAnnualProvisions(ctx)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, provisions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryBlockProvision(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	provision := k.GetNextBlockProvision(ctx)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, provision)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryInflationSchedule(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	schedule := k.GetParamSet(ctx).InflationSchedule
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, schedule)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryActiveInflationSegment(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	activeSegment := k.GetActiveInflationSegment(ctx)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, activeSegment)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func querySupplyHeadroom(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	headroom := k.GetSupplyHeadroom(ctx)
+
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, headroom)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -7,6 +7,8 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/irisnet/irishub/modules/mint/keeper"
 	"github.com/irisnet/irishub/modules/mint/types"
 )
@@ -29,4 +31,59 @@ func (suite *KeeperTestSuite) TestNewQuerier() {
 	e := suite.cdc.UnmarshalJSON(res, &params)
 	suite.NoError(e)
 	suite.Equal(suite.app.MintKeeper.GetParamSet(suite.ctx), params)
+
+	minter := suite.app.MintKeeper.GetMinter(suite.ctx)
+
+	// test queryMinter
+
+	res, err = querier(suite.ctx, []string{types.QueryMinter}, abci.RequestQuery{})
+	suite.NoError(err)
+	var queriedMinter types.Minter
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &queriedMinter))
+	suite.Equal(minter, queriedMinter)
+
+	// test queryAnnualProvisions
+
+	res, err = querier(suite.ctx, []string{types.QueryAnnualProvisions}, abci.RequestQuery{})
+	suite.NoError(err)
+	var annualProvisions sdk.DecCoin
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &annualProvisions))
+	suite.Equal(sdk.NewDecCoinFromDec(params.MintDenom, minter.NextAnnualProvisions(params)), annualProvisions)
+
+	// test queryBlockProvision
+
+	res, err = querier(suite.ctx, []string{types.QueryBlockProvision}, abci.RequestQuery{})
+	suite.NoError(err)
+	var blockProvision sdk.Coin
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &blockProvision))
+	suite.Equal(minter.NextBlockProvision(params), blockProvision)
+
+	params.InflationSchedule = []types.InflationSegment{types.NewTimeInflationSegment(suite.ctx.BlockTime(), sdk.NewDecWithPrec(8, 2))}
+	suite.app.MintKeeper.SetParamSet(suite.ctx, params)
+
+	// test queryInflationSchedule
+
+	res, err = querier(suite.ctx, []string{types.QueryInflationSchedule}, abci.RequestQuery{})
+	suite.NoError(err)
+	var schedule []types.InflationSegment
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &schedule))
+	suite.Equal(params.InflationSchedule, schedule)
+
+	// test queryActiveInflationSegment
+
+	res, err = querier(suite.ctx, []string{types.QueryActiveInflationSegment}, abci.RequestQuery{})
+	suite.NoError(err)
+	var activeSegment types.ActiveInflationSegment
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &activeSegment))
+	suite.Equal(int64(0), activeSegment.Index)
+	suite.Equal(sdk.NewDecWithPrec(8, 2), activeSegment.Inflation)
+
+	// test querySupplyHeadroom
+
+	res, err = querier(suite.ctx, []string{types.QuerySupplyHeadroom}, abci.RequestQuery{})
+	suite.NoError(err)
+	var headroom types.SupplyHeadroom
+	suite.NoError(suite.cdc.UnmarshalJSON(res, &headroom))
+	suite.Equal(suite.app.MintKeeper.GetSupplyHeadroom(suite.ctx), headroom)
+	suite.Equal(sdk.NewCoin(params.MintDenom, params.MaxSupply), headroom.MaxSupply)
 }
@@ -20,6 +20,16 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &minterA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.Equal(kvA.Key, types.TotalMintedKey), bytes.Equal(kvA.Key[:1], types.CheckpointKey):
+			var checkpointA, checkpointB types.MintCheckpoint
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &checkpointA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &checkpointB)
+			return fmt.Sprintf("%v\n%v", checkpointA, checkpointB)
+		case bytes.Equal(kvA.Key, types.SupplyBaselineKey):
+			var baselineA, baselineB types.SupplyBaseline
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &baselineA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &baselineB)
+			return fmt.Sprintf("%v\n%v", baselineA, baselineB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
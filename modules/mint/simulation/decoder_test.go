@@ -19,10 +19,12 @@ func TestDecodeStore(t *testing.T) {
 	minter := types.NewMinter(time.Now().UTC(), sdk.NewIntWithDecimal(2, 9))
 	cdc, _ := simapp.MakeCodecs()
 	dec := simulation.NewDecodeStore(cdc)
+	checkpoint := types.NewMintCheckpoint(10, time.Now().UTC(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: cdc.MustMarshalBinaryBare(&minter)},
+			{Key: types.GetCheckpointKey(checkpoint.Height), Value: cdc.MustMarshalBinaryBare(&checkpoint)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +33,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"MintCheckpoint", fmt.Sprintf("%v\n%v", checkpoint, checkpoint)},
 		{"other", ""},
 	}
 
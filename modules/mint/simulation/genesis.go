@@ -6,16 +6,30 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/irisnet/irishub/modules/mint/types"
 )
 
 // Simulation parameter constants
 const (
-	Inflation = "inflation"
+	Inflation           = "inflation"
+	ProvisionMode       = "provision_mode"
+	MaxBlockDuration    = "max_block_duration"
+	InflationSchedule   = "inflation_schedule"
+	InflationMode       = "inflation_mode"
+	InflationRateChange = "inflation_rate_change"
+	InflationMax        = "inflation_max"
+	InflationMin        = "inflation_min"
+	GoalBonded          = "goal_bonded"
+	Recipients          = "recipients"
+	MaxSupply           = "max_supply"
+	RebasePeriod        = "rebase_period"
+	CheckpointInterval  = "checkpoint_interval"
 )
 
 // GenInflation randomized Inflation
@@ -23,6 +37,87 @@ func GenInflation(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
 }
 
+// GenProvisionMode randomized ProvisionMode
+func GenProvisionMode(r *rand.Rand) types.ProvisionMode {
+	return types.ProvisionMode(r.Intn(len(types.ProvisionMode_name)))
+}
+
+// GenMaxBlockDuration randomized MaxBlockDuration
+func GenMaxBlockDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 5, 600)) * time.Second
+}
+
+// GenInflationSchedule randomized InflationSchedule, whose segments start at ascending heights
+func GenInflationSchedule(r *rand.Rand) []types.InflationSegment {
+	var schedule []types.InflationSegment
+	var height int64
+	for i := r.Intn(4); i > 0; i-- {
+		height += int64(simulation.RandIntBetween(r, 1, 100))
+		if r.Intn(2) == 0 {
+			schedule = append(schedule, types.NewHalvingInflationSegment(height, nil))
+		} else {
+			schedule = append(schedule, types.NewHeightInflationSegment(height, sdk.NewDecWithPrec(int64(r.Intn(21)), 2)))
+		}
+	}
+	return schedule
+}
+
+// GenInflationMode randomized InflationMode
+func GenInflationMode(r *rand.Rand) types.InflationMode {
+	return types.InflationMode(r.Intn(len(types.InflationMode_name)))
+}
+
+// GenInflationRateChange randomized InflationRateChange
+func GenInflationRateChange(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
+}
+
+// GenInflationMax randomized InflationMax, which is never below the result of GenInflationMin
+func GenInflationMax(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 10, 21)), 2)
+}
+
+// GenInflationMin randomized InflationMin, which is never above the result of GenInflationMax
+func GenInflationMin(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(11)), 2)
+}
+
+// GenGoalBonded randomized GoalBonded
+func GenGoalBonded(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 50, 100)), 2)
+}
+
+// GenMintRecipients randomized Recipients, which split the minted coins between the fee
+// collector and the community pool
+func GenMintRecipients(r *rand.Rand) []types.MintRecipient {
+	communityPoolWeight := sdk.NewDecWithPrec(int64(r.Intn(50)), 2)
+	if communityPoolWeight.IsZero() {
+		return types.DefaultMintRecipients()
+	}
+	return []types.MintRecipient{
+		types.NewMintRecipient(types.RecipientFeeCollector, "", sdk.OneDec().Sub(communityPoolWeight)),
+		types.NewMintRecipient(types.RecipientCommunityPool, "", communityPoolWeight),
+	}
+}
+
+// GenMaxSupply randomized MaxSupply, which is at least the supply of the default minter
+func GenMaxSupply(r *rand.Rand) sdk.Int {
+	return types.DefaultMinter().InflationBase.MulRaw(int64(simulation.RandIntBetween(r, 1, 10)))
+}
+
+// GenRebasePeriod randomized RebasePeriod, which disables rebasing half of the time
+func GenRebasePeriod(r *rand.Rand) time.Duration {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return time.Duration(simulation.RandIntBetween(r, 1, 24)) * time.Hour
+}
+
+// GenCheckpointInterval randomized CheckpointInterval
+func GenCheckpointInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -32,8 +127,84 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { inflation = GenInflation(r) },
 	)
 
-	params := types.Params{Inflation: inflation, MintDenom: types.MintDenom}
-	mintGenesis := types.NewGenesisState(types.DefaultMinter(), params)
+	var provisionMode types.ProvisionMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ProvisionMode, &provisionMode, simState.Rand,
+		func(r *rand.Rand) { provisionMode = GenProvisionMode(r) },
+	)
+
+	var maxBlockDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxBlockDuration, &maxBlockDuration, simState.Rand,
+		func(r *rand.Rand) { maxBlockDuration = GenMaxBlockDuration(r) },
+	)
+
+	var inflationSchedule []types.InflationSegment
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationSchedule, &inflationSchedule, simState.Rand,
+		func(r *rand.Rand) { inflationSchedule = GenInflationSchedule(r) },
+	)
+
+	var inflationMode types.InflationMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationMode, &inflationMode, simState.Rand,
+		func(r *rand.Rand) { inflationMode = GenInflationMode(r) },
+	)
+
+	var inflationRateChange sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationRateChange, &inflationRateChange, simState.Rand,
+		func(r *rand.Rand) { inflationRateChange = GenInflationRateChange(r) },
+	)
+
+	var inflationMax sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationMax, &inflationMax, simState.Rand,
+		func(r *rand.Rand) { inflationMax = GenInflationMax(r) },
+	)
+
+	var inflationMin sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationMin, &inflationMin, simState.Rand,
+		func(r *rand.Rand) { inflationMin = GenInflationMin(r) },
+	)
+
+	var goalBonded sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, GoalBonded, &goalBonded, simState.Rand,
+		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
+	)
+
+	var recipients []types.MintRecipient
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Recipients, &recipients, simState.Rand,
+		func(r *rand.Rand) { recipients = GenMintRecipients(r) },
+	)
+
+	var maxSupply sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupply, &maxSupply, simState.Rand,
+		func(r *rand.Rand) { maxSupply = GenMaxSupply(r) },
+	)
+
+	var rebasePeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RebasePeriod, &rebasePeriod, simState.Rand,
+		func(r *rand.Rand) { rebasePeriod = GenRebasePeriod(r) },
+	)
+
+	var checkpointInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CheckpointInterval, &checkpointInterval, simState.Rand,
+		func(r *rand.Rand) { checkpointInterval = GenCheckpointInterval(r) },
+	)
+
+	params := types.NewParams(
+		types.MintDenom, inflation, provisionMode, maxBlockDuration, inflationSchedule,
+		inflationMode, inflationRateChange, inflationMax, inflationMin, goalBonded,
+		recipients, maxSupply, rebasePeriod, checkpointInterval,
+	)
+	mintGenesis := types.NewGenesisState(types.DefaultMinter(), params, types.DefaultTotalMinted(), nil)
 
 	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
 	if err != nil {
@@ -23,5 +23,75 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenInflation(r))
 			},
 		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyProvisionMode),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenProvisionMode(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyMaxBlockDuration),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxBlockDuration(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyInflationMode),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenInflationMode(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyInflationRateChange),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenInflationRateChange(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyInflationMax),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenInflationMax(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyInflationMin),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenInflationMin(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyGoalBonded),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenGoalBonded(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyMaxSupply),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxSupply(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyRebasePeriod),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenRebasePeriod(r))
+			},
+		),
+		simulation.NewSimParamChange(
+			types.ModuleName,
+			string(types.KeyCheckpointInterval),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenCheckpointInterval(r))
+			},
+		),
 	}
 }
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMintCheckpoint creates a checkpoint of the total minted coins at the given block
+func NewMintCheckpoint(height int64, blockTime time.Time, totalMinted sdk.Coins) MintCheckpoint {
+	return MintCheckpoint{
+		Height:      height,
+		Time:        blockTime,
+		TotalMinted: totalMinted,
+	}
+}
+
+// DefaultTotalMinted returns the total minted coins of a new chain
+func DefaultTotalMinted() MintCheckpoint {
+	return NewMintCheckpoint(0, time.Unix(0, 0).UTC(), nil)
+}
+
+// Validate returns err if the checkpoint is invalid
+func (c MintCheckpoint) Validate() error {
+	if c.Height < 0 {
+		return fmt.Errorf("checkpoint height (%d) should not be negative", c.Height)
+	}
+	if err := c.TotalMinted.Validate(); err != nil {
+		return fmt.Errorf("invalid total minted coins: %w", err)
+	}
+	return nil
+}
+
+// ValidateCheckpoints returns err if any checkpoint is invalid, the heights are not strictly
+// ascending, or the totals decrease or exceed the given total minted coins
+func ValidateCheckpoints(checkpoints []MintCheckpoint, totalMinted MintCheckpoint) error {
+	if err := totalMinted.Validate(); err != nil {
+		return err
+	}
+
+	var last *MintCheckpoint
+	for i, checkpoint := range checkpoints {
+		if err := checkpoint.Validate(); err != nil {
+			return fmt.Errorf("invalid checkpoint %d: %w", i, err)
+		}
+		if checkpoint.Height <= 0 || checkpoint.Height > totalMinted.Height {
+			return fmt.Errorf("checkpoint %d at height %d is not between 0 and the last minting height %d", i, checkpoint.Height, totalMinted.Height)
+		}
+		if !checkpoint.TotalMinted.IsAllLTE(totalMinted.TotalMinted) {
+			return fmt.Errorf("checkpoint %d total %s exceeds the total minted coins %s", i, checkpoint.TotalMinted, totalMinted.TotalMinted)
+		}
+		if last != nil {
+			if checkpoint.Height <= last.Height {
+				return fmt.Errorf("checkpoint %d at height %d is not after the previous checkpoint at height %d", i, checkpoint.Height, last.Height)
+			}
+			if !last.TotalMinted.IsAllLTE(checkpoint.TotalMinted) {
+				return fmt.Errorf("checkpoint %d total %s is less than the previous total %s", i, checkpoint.TotalMinted, last.TotalMinted)
+			}
+		}
+		last = &checkpoints[i]
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateCheckpoints(t *testing.T) {
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	totalMinted := NewMintCheckpoint(30, blockTime, coins(300))
+
+	tests := []struct {
+		name        string
+		expectPass  bool
+		checkpoints []MintCheckpoint
+		totalMinted MintCheckpoint
+	}{
+		{"default", true, nil, DefaultTotalMinted()},
+		{"ascending", true, []MintCheckpoint{
+			NewMintCheckpoint(10, blockTime, coins(100)),
+			NewMintCheckpoint(20, blockTime, coins(200)),
+			NewMintCheckpoint(30, blockTime, coins(300)),
+		}, totalMinted},
+		{"equal totals", true, []MintCheckpoint{
+			NewMintCheckpoint(10, blockTime, coins(100)),
+			NewMintCheckpoint(20, blockTime, coins(100)),
+		}, totalMinted},
+		{"negative total height", false, nil, NewMintCheckpoint(-1, blockTime, nil)},
+		{"invalid total coins", false, nil, NewMintCheckpoint(1, blockTime, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}})},
+		{"zero height", false, []MintCheckpoint{NewMintCheckpoint(0, blockTime, nil)}, totalMinted},
+		{"height after total", false, []MintCheckpoint{NewMintCheckpoint(40, blockTime, coins(100))}, totalMinted},
+		{"duplicate height", false, []MintCheckpoint{
+			NewMintCheckpoint(10, blockTime, coins(100)),
+			NewMintCheckpoint(10, blockTime, coins(100)),
+		}, totalMinted},
+		{"descending height", false, []MintCheckpoint{
+			NewMintCheckpoint(20, blockTime, coins(100)),
+			NewMintCheckpoint(10, blockTime, coins(100)),
+		}, totalMinted},
+		{"decreasing total", false, []MintCheckpoint{
+			NewMintCheckpoint(10, blockTime, coins(200)),
+			NewMintCheckpoint(20, blockTime, coins(100)),
+		}, totalMinted},
+		{"total exceeded", false, []MintCheckpoint{NewMintCheckpoint(10, blockTime, coins(400))}, totalMinted},
+	}
+	for _, tc := range tests {
+		err := ValidateCheckpoints(tc.checkpoints, tc.totalMinted)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
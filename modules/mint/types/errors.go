@@ -6,6 +6,13 @@ import (
 
 // mint module sentinel errors
 var (
-	ErrInvalidMintInflation = sdkerrors.Register(ModuleName, 2, "invalid mint inflation")
-	ErrInvalidMintDenom     = sdkerrors.Register(ModuleName, 3, "invalid mint denom")
+	ErrInvalidMintInflation     = sdkerrors.Register(ModuleName, 2, "invalid mint inflation")
+	ErrInvalidMintDenom         = sdkerrors.Register(ModuleName, 3, "invalid mint denom")
+	ErrInvalidProvisionMode     = sdkerrors.Register(ModuleName, 4, "invalid provision mode")
+	ErrInvalidMaxBlockDuration  = sdkerrors.Register(ModuleName, 5, "invalid max block duration")
+	ErrInvalidInflationSchedule = sdkerrors.Register(ModuleName, 6, "invalid inflation schedule")
+	ErrInvalidDynamicInflation  = sdkerrors.Register(ModuleName, 7, "invalid dynamic inflation params")
+	ErrInvalidMintRecipients    = sdkerrors.Register(ModuleName, 8, "invalid mint recipients")
+	ErrInvalidMaxSupply         = sdkerrors.Register(ModuleName, 9, "invalid max supply")
+	ErrInvalidRebasePeriod      = sdkerrors.Register(ModuleName, 10, "invalid rebase period")
 )
@@ -3,9 +3,22 @@ package types
 
 // mint module event types
 const (
-	EventTypeMint = "mint"
+	EventTypeMint         = "mint"
+	EventTypeMintTransfer = "mint_transfer"
+
+	EventTypeSupplyCapReached = "supply_cap_reached"
+	EventTypeRebase           = "rebase"
 
 	AttributeKeyLastInflationTime = "last_inflation_time"
 	AttributeKeyInflationTime     = "inflation_time"
 	AttributeKeyMintCoin          = "mint_coin"
+	AttributeKeyInflation         = "inflation"
+	AttributeKeyRecipientType     = "recipient_type"
+	AttributeKeyRecipient         = "recipient"
+	AttributeKeyAmount            = "amount"
+	AttributeKeySupply            = "supply"
+	AttributeKeyMaxSupply         = "max_supply"
+	AttributeKeyLastInflationBase = "last_inflation_base"
+	AttributeKeyInflationBase     = "inflation_base"
+	AttributeKeyRebaseTime        = "rebase_time"
 )
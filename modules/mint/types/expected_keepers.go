@@ -3,6 +3,7 @@ package types // noalias
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
 )
 
 // accountKeeper defines the contract required for account APIs.
@@ -20,4 +21,16 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	GetSupply(ctx sdk.Context) bankexported.SupplyI
+}
+
+// StakingKeeper defines the staking keeper contract required to determine the bonded ratio
+type StakingKeeper interface {
+	BondedRatio(ctx sdk.Context) sdk.Dec
+}
+
+// DistrKeeper defines the distribution keeper contract required to fund the community pool
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
@@ -1,18 +1,21 @@
 package types
 
 // NewGenesisState constructs a GenesisState
-func NewGenesisState(minter Minter, params Params) *GenesisState {
+func NewGenesisState(minter Minter, params Params, totalMinted MintCheckpoint, checkpoints []MintCheckpoint) *GenesisState {
 	return &GenesisState{
-		Minter: minter,
-		Params: params,
+		Minter:      minter,
+		Params:      params,
+		TotalMinted: totalMinted,
+		Checkpoints: checkpoints,
 	}
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Minter: DefaultMinter(),
-		Params: DefaultParams(),
+		Minter:      DefaultMinter(),
+		Params:      DefaultParams(),
+		TotalMinted: DefaultTotalMinted(),
 	}
 }
 
@@ -22,5 +25,8 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
-	return ValidateMinter(data.Minter)
+	if err := ValidateMinter(data.Minter); err != nil {
+		return err
+	}
+	return ValidateCheckpoints(data.Checkpoints, data.TotalMinted)
 }
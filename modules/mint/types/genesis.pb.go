@@ -25,8 +25,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the guardian module's genesis state.
 type GenesisState struct {
-	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	Minter      Minter           `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
+	Params      Params           `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	TotalMinted MintCheckpoint   `protobuf:"bytes,3,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted" yaml:"total_minted"`
+	Checkpoints []MintCheckpoint `protobuf:"bytes,4,rep,name=checkpoints,proto3" json:"checkpoints"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTotalMinted() MintCheckpoint {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return MintCheckpoint{}
+}
+
+func (m *GenesisState) GetCheckpoints() []MintCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irishub.mint.GenesisState")
 }
@@ -83,20 +99,25 @@ func init() {
 func init() { proto.RegisterFile("mint/genesis.proto", fileDescriptor_50813f2cd53c1776) }
 
 var fileDescriptor_50813f2cd53c1776 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
+	// 280 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0xcd, 0xcc, 0x2b,
 	0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
 	0xc9, 0x2c, 0xca, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x03, 0xc9, 0x49, 0xf1, 0x83, 0x55, 0x80, 0x08,
-	0x88, 0xb4, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xca,
-	0xb8, 0x78, 0xdc, 0x21, 0xa6, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x19, 0x71, 0xb1, 0x81, 0xf4,
-	0xa4, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x21, 0x9b, 0xaa, 0xe7, 0x0b,
-	0x96, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x12, 0xa4, 0xa7, 0x20, 0xb1, 0x28,
-	0x31, 0xb7, 0x58, 0x82, 0x09, 0x9b, 0x9e, 0x00, 0xb0, 0x1c, 0x4c, 0x0f, 0x44, 0xa5, 0x93, 0xfb,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x80, 0xf4,
-	0x26, 0xe7, 0xe7, 0xea, 0x83, 0xcc, 0xc9, 0x4b, 0x2d, 0xd1, 0x87, 0x9a, 0xa7, 0x9f, 0x9b, 0x9f,
-	0x52, 0x9a, 0x93, 0x5a, 0x0c, 0xf6, 0x96, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x1f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x26, 0x3f, 0xb8, 0x15, 0x12, 0x01, 0x00, 0x00,
+	0x88, 0xb4, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x66,
+	0x31, 0x71, 0xf1, 0xb8, 0x43, 0x8c, 0x09, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe2, 0x62, 0x03,
+	0x69, 0x4a, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x12, 0xd1, 0x43, 0x36, 0x56, 0xcf,
+	0x17, 0x2c, 0xe7, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x25, 0x48, 0x4f, 0x41, 0x62,
+	0x51, 0x62, 0x6e, 0xb1, 0x04, 0x13, 0x36, 0x3d, 0x01, 0x60, 0x39, 0x98, 0x1e, 0x88, 0x4a, 0xa1,
+	0x18, 0x2e, 0x9e, 0x92, 0xfc, 0x92, 0xc4, 0x9c, 0x78, 0xb0, 0x19, 0x29, 0x12, 0xcc, 0x60, 0x9d,
+	0x32, 0x98, 0xb6, 0x39, 0x67, 0xa4, 0x26, 0x67, 0x17, 0xe4, 0x67, 0xe6, 0x95, 0x38, 0x49, 0x83,
+	0x4c, 0xf8, 0x74, 0x4f, 0x5e, 0xb8, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0x59, 0xbf, 0x52, 0x10,
+	0x37, 0x98, 0x0b, 0x76, 0x5f, 0x8a, 0x90, 0x0b, 0x17, 0x77, 0x32, 0x5c, 0x5f, 0xb1, 0x04, 0x8b,
+	0x02, 0x33, 0x41, 0xc3, 0x21, 0xce, 0x43, 0xd6, 0xe6, 0xe4, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
+	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
+	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xba, 0xe9, 0x99, 0x25, 0x20, 0x83, 0x92, 0xf3, 0x73, 0xf5, 0x41,
+	0x86, 0xe6, 0xa5, 0x96, 0xe8, 0x43, 0x0d, 0xd7, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06,
+	0x87, 0xbd, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xb0, 0x8d, 0x01, 0x03, 0x00,
+	0x0f, 0x83, 0xe3, 0x25, 0xb7, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +140,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.TotalMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -163,6 +208,14 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -267,6 +320,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, MintCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // nolint
 const (
 	// ModuleName defines the module name
@@ -15,11 +19,25 @@ const (
 	QuerierRoute = ModuleName
 
 	// Query endpoints supported by the minting querier
-	QueryParameters = "parameters"
-	QueryInflation  = "inflation"
+	QueryParameters             = "parameters"
+	QueryInflation              = "inflation"
+	QueryMinter                 = "minter"
+	QueryAnnualProvisions       = "annual_provisions"
+	QueryBlockProvision         = "block_provision"
+	QueryInflationSchedule      = "inflation_schedule"
+	QueryActiveInflationSegment = "active_inflation_segment"
+	QuerySupplyHeadroom         = "supply_headroom"
 )
 
 var (
 	// use for the keeper store
-	MinterKey = []byte{0x00}
+	MinterKey         = []byte{0x00}
+	TotalMintedKey    = []byte{0x01} // key for the running total of the minted coins
+	SupplyBaselineKey = []byte{0x02} // key for the supply and the total minted coins at genesis
+	CheckpointKey     = []byte{0x03} // key for the checkpoints of the total minted coins
 )
+
+// GetCheckpointKey returns the key of the checkpoint at the given height
+func GetCheckpointKey(height int64) []byte {
+	return append(CheckpointKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
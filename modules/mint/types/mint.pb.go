@@ -6,9 +6,11 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
@@ -28,12 +30,109 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RecipientType defines the kind of a recipient of the minted coins
+type RecipientType int32
+
+const (
+	// RECIPIENT_TYPE_FEE_COLLECTOR sends the coins to the fee collector, which distributes them to the stakers
+	RecipientFeeCollector RecipientType = 0
+	// RECIPIENT_TYPE_COMMUNITY_POOL funds the community pool of the distribution module
+	RecipientCommunityPool RecipientType = 1
+	// RECIPIENT_TYPE_MODULE sends the coins to a module account
+	RecipientModule RecipientType = 2
+	// RECIPIENT_TYPE_ACCOUNT sends the coins to an account address
+	RecipientAccount RecipientType = 3
+)
+
+var RecipientType_name = map[int32]string{
+	0: "RECIPIENT_TYPE_FEE_COLLECTOR",
+	1: "RECIPIENT_TYPE_COMMUNITY_POOL",
+	2: "RECIPIENT_TYPE_MODULE",
+	3: "RECIPIENT_TYPE_ACCOUNT",
+}
+
+var RecipientType_value = map[string]int32{
+	"RECIPIENT_TYPE_FEE_COLLECTOR":  0,
+	"RECIPIENT_TYPE_COMMUNITY_POOL": 1,
+	"RECIPIENT_TYPE_MODULE":         2,
+	"RECIPIENT_TYPE_ACCOUNT":        3,
+}
+
+func (x RecipientType) String() string {
+	return proto.EnumName(RecipientType_name, int32(x))
+}
+
+func (RecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{0}
+}
+
+// InflationMode defines how the inflation rate is determined
+type InflationMode int32
+
+const (
+	// INFLATION_MODE_FIXED applies the inflation rate of the params, or of the active segment of the inflation schedule
+	InflationModeFixed InflationMode = 0
+	// INFLATION_MODE_DYNAMIC moves the inflation rate each block between the minimum and the maximum
+	// toward the target bonded ratio
+	InflationModeDynamic InflationMode = 1
+)
+
+var InflationMode_name = map[int32]string{
+	0: "INFLATION_MODE_FIXED",
+	1: "INFLATION_MODE_DYNAMIC",
+}
+
+var InflationMode_value = map[string]int32{
+	"INFLATION_MODE_FIXED":   0,
+	"INFLATION_MODE_DYNAMIC": 1,
+}
+
+func (x InflationMode) String() string {
+	return proto.EnumName(InflationMode_name, int32(x))
+}
+
+func (InflationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{1}
+}
+
+// ProvisionMode defines how the block provisions are derived from the annual provisions
+type ProvisionMode int32
+
+const (
+	// PROVISION_MODE_BLOCK mints a fixed fraction of the annual provisions per block, assuming 5 second blocks
+	ProvisionModeBlock ProvisionMode = 0
+	// PROVISION_MODE_TIME mints in proportion to the BFT time elapsed since the previous block
+	ProvisionModeTime ProvisionMode = 1
+)
+
+var ProvisionMode_name = map[int32]string{
+	0: "PROVISION_MODE_BLOCK",
+	1: "PROVISION_MODE_TIME",
+}
+
+var ProvisionMode_value = map[string]int32{
+	"PROVISION_MODE_BLOCK": 0,
+	"PROVISION_MODE_TIME":  1,
+}
+
+func (x ProvisionMode) String() string {
+	return proto.EnumName(ProvisionMode_name, int32(x))
+}
+
+func (ProvisionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{2}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// time which the last update was made to the minter
 	LastUpdate time.Time `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3,stdtime" json:"last_update" yaml:"last_update"`
 	// base inflation
 	InflationBase github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inflation_base,json=inflationBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflation_base" yaml:"inflation_base"`
+	// inflation rate in effect at the last update
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation" yaml:"inflation"`
+	// time at which the inflation base was last rebased on the total supply
+	LastRebase time.Time `protobuf:"bytes,4,opt,name=last_rebase,json=lastRebase,proto3,stdtime" json:"last_rebase" yaml:"last_rebase"`
 }
 
 func (m *Minter) Reset()         { *m = Minter{} }
@@ -76,12 +175,44 @@ func (m *Minter) GetLastUpdate() time.Time {
 	return time.Time{}
 }
 
+func (m *Minter) GetLastRebase() time.Time {
+	if m != nil {
+		return m.LastRebase
+	}
+	return time.Time{}
+}
+
 // mint parameters
 type Params struct {
 	// type of coin to mint
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
 	// inflation rate
 	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// mode in which the block provisions are calculated
+	ProvisionMode ProvisionMode `protobuf:"varint,3,opt,name=provision_mode,json=provisionMode,proto3,enum=irishub.mint.ProvisionMode" json:"provision_mode,omitempty" yaml:"provision_mode"`
+	// maximum time span minted for in a single block in the time mode, which caps the provisions after a halt
+	MaxBlockDuration time.Duration `protobuf:"bytes,4,opt,name=max_block_duration,json=maxBlockDuration,proto3,stdduration" json:"max_block_duration" yaml:"max_block_duration"`
+	// segments which replace the inflation rate once their boundaries are reached, in ascending order
+	// and all starting at either a block height or a block time
+	InflationSchedule []InflationSegment `protobuf:"bytes,5,rep,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule" yaml:"inflation_schedule"`
+	// mode in which the inflation rate is determined
+	InflationMode InflationMode `protobuf:"varint,6,opt,name=inflation_mode,json=inflationMode,proto3,enum=irishub.mint.InflationMode" json:"inflation_mode,omitempty" yaml:"inflation_mode"`
+	// maximum annual change of the inflation rate in the dynamic mode
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change" yaml:"inflation_rate_change"`
+	// maximum inflation rate in the dynamic mode
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max" yaml:"inflation_max"`
+	// minimum inflation rate in the dynamic mode
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min" yaml:"inflation_min"`
+	// bonded ratio targeted by the dynamic mode
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
+	// recipients of the minted coins with weights adding up to 1, all coins go to the fee collector if empty
+	Recipients []MintRecipient `protobuf:"bytes,11,rep,name=recipients,proto3" json:"recipients"`
+	// maximum total supply of the mint denom, minting stops once it is reached
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// period after which the inflation base is rebased on the total supply of the mint denom, 0 disables rebasing
+	RebasePeriod time.Duration `protobuf:"bytes,13,opt,name=rebase_period,json=rebasePeriod,proto3,stdduration" json:"rebase_period" yaml:"rebase_period"`
+	// number of blocks between two checkpoints of the total minted coins, 0 disables checkpoints
+	CheckpointInterval uint64 `protobuf:"varint,14,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty" yaml:"checkpoint_interval"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -123,167 +254,2505 @@ func (m *Params) GetMintDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Minter)(nil), "irishub.mint.Minter")
-	proto.RegisterType((*Params)(nil), "irishub.mint.Params")
+func (m *Params) GetProvisionMode() ProvisionMode {
+	if m != nil {
+		return m.ProvisionMode
+	}
+	return ProvisionModeBlock
 }
 
-func init() { proto.RegisterFile("mint/mint.proto", fileDescriptor_e1b9fbb701b2a577) }
+func (m *Params) GetMaxBlockDuration() time.Duration {
+	if m != nil {
+		return m.MaxBlockDuration
+	}
+	return 0
+}
 
-var fileDescriptor_e1b9fbb701b2a577 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x33, 0x97, 0x4b, 0x21, 0xd3, 0x7b, 0x15, 0x82, 0x42, 0x29, 0x98, 0x94, 0x2c, 0xa4,
-	0x9b, 0xce, 0x80, 0xee, 0xba, 0x0c, 0x85, 0x22, 0x28, 0x48, 0xd0, 0x8d, 0x2e, 0xca, 0xa4, 0x99,
-	0xc6, 0xc1, 0xcc, 0x4c, 0xc8, 0x4c, 0x16, 0xc5, 0x97, 0xe8, 0xd2, 0xa5, 0x8f, 0xd3, 0x65, 0x37,
-	0x82, 0xb8, 0x88, 0xd2, 0xbe, 0x41, 0x9f, 0x40, 0x26, 0x69, 0x6b, 0x5d, 0xba, 0x49, 0xe6, 0xfc,
-	0x73, 0xce, 0x37, 0xe7, 0x9c, 0x1f, 0x1e, 0x72, 0x26, 0x34, 0x36, 0x1f, 0x94, 0xe5, 0x52, 0x4b,
-	0xe7, 0x1f, 0xcb, 0x99, 0x7a, 0x28, 0x22, 0x64, 0xb4, 0xf6, 0x51, 0x22, 0x13, 0x59, 0x5d, 0x60,
-	0x73, 0xaa, 0x73, 0xda, 0x5e, 0x22, 0x65, 0x92, 0x52, 0x5c, 0x45, 0x51, 0x31, 0xc1, 0x9a, 0x71,
-	0xaa, 0x34, 0xe1, 0x59, 0x9d, 0xe0, 0xbf, 0x02, 0xd8, 0xb8, 0x62, 0x42, 0xd3, 0xdc, 0xb9, 0x87,
-	0xcd, 0x94, 0x28, 0x3d, 0x2a, 0xb2, 0x98, 0x68, 0xda, 0x02, 0x1d, 0xd0, 0x6d, 0x9e, 0xb5, 0x51,
-	0x4d, 0x40, 0x5b, 0x02, 0xba, 0xd9, 0x12, 0x02, 0x77, 0x5e, 0x7a, 0xd6, 0xba, 0xf4, 0x9c, 0x29,
-	0xe1, 0x69, 0xdf, 0xdf, 0x2b, 0xf6, 0x67, 0x1f, 0x1e, 0x08, 0xa1, 0x51, 0x6e, 0x2b, 0xc1, 0x11,
-	0xf0, 0x80, 0x89, 0x49, 0x4a, 0x34, 0x93, 0x62, 0x14, 0x11, 0x45, 0x5b, 0x7f, 0x3a, 0xa0, 0x6b,
-	0x07, 0x43, 0xc3, 0x78, 0x2f, 0xbd, 0xd3, 0x84, 0x69, 0x33, 0xcb, 0x58, 0x72, 0x3c, 0x96, 0x8a,
-	0x4b, 0xb5, 0xf9, 0xf5, 0x54, 0xfc, 0x88, 0xf5, 0x34, 0xa3, 0x0a, 0x5d, 0x08, 0xbd, 0x2e, 0xbd,
-	0xe3, 0xfa, 0xb5, 0x9f, 0x34, 0x3f, 0xfc, 0xbf, 0x13, 0x02, 0x13, 0x3f, 0xc1, 0xc6, 0x35, 0xc9,
-	0x09, 0x57, 0xce, 0x09, 0x84, 0x66, 0x41, 0xa3, 0x98, 0x0a, 0xc9, 0xab, 0xa9, 0xec, 0xd0, 0x36,
-	0xca, 0xc0, 0x08, 0xce, 0x25, 0xb4, 0x77, 0x95, 0x9b, 0x9e, 0xd0, 0x2f, 0x7a, 0x1a, 0xd0, 0x71,
-	0xf8, 0x0d, 0xe8, 0xff, 0x7d, 0x7e, 0xf1, 0xac, 0x60, 0x38, 0x5f, 0xba, 0x60, 0xb1, 0x74, 0xc1,
-	0xe7, 0xd2, 0x05, 0xb3, 0x95, 0x6b, 0x2d, 0x56, 0xae, 0xf5, 0xb6, 0x72, 0xad, 0xbb, 0xde, 0x1e,
-	0xd2, 0xd8, 0x27, 0xa8, 0xc6, 0x1b, 0x1b, 0x31, 0x97, 0x71, 0x91, 0x52, 0x55, 0x59, 0x5c, 0xd3,
-	0xa3, 0x46, 0xb5, 0xf5, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x4e, 0xb8, 0x09, 0xfc,
-	0x01, 0x00, 0x00,
+func (m *Params) GetInflationSchedule() []InflationSegment {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return nil
 }
 
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetInflationMode() InflationMode {
+	if m != nil {
+		return m.InflationMode
 	}
-	return dAtA[:n], nil
+	return InflationModeFixed
 }
 
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetRecipients() []MintRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
 }
 
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.InflationBase.Size()
-		i -= size
-		if _, err := m.InflationBase.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *Params) GetRebasePeriod() time.Duration {
+	if m != nil {
+		return m.RebasePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetCheckpointInterval() uint64 {
+	if m != nil {
+		return m.CheckpointInterval
+	}
+	return 0
+}
+
+// MintRecipient defines a recipient of a weighted share of the minted coins
+type MintRecipient struct {
+	// type of the recipient
+	Type RecipientType `protobuf:"varint,1,opt,name=type,proto3,enum=irishub.mint.RecipientType" json:"type,omitempty"`
+	// module name of a module recipient, or bech32 address of an account recipient, empty otherwise
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// share of the minted coins sent to the recipient
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *MintRecipient) Reset()         { *m = MintRecipient{} }
+func (m *MintRecipient) String() string { return proto.CompactTextString(m) }
+func (*MintRecipient) ProtoMessage()    {}
+func (*MintRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{2}
+}
+func (m *MintRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate):])
-	if err1 != nil {
-		return 0, err1
+}
+func (m *MintRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecipient.Merge(m, src)
+}
+func (m *MintRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecipient proto.InternalMessageInfo
+
+func (m *MintRecipient) GetType() RecipientType {
+	if m != nil {
+		return m.Type
 	}
-	i -= n1
-	i = encodeVarintMint(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return RecipientFeeCollector
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MintRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InflationSegment defines a segment of the inflation schedule, which is active from
+// either a block height or a block time until the boundary of the next segment is reached
+type InflationSegment struct {
+	// height from which the segment is active, 0 if the segment starts at a time
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// time from which the segment is active, empty if the segment starts at a height
+	StartTime *time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	// inflation rate of the segment, empty if halving is set
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// halving defines whether the inflation rate is the half of the rate in effect before the segment
+	Halving bool `protobuf:"varint,4,opt,name=halving,proto3" json:"halving,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *InflationSegment) Reset()         { *m = InflationSegment{} }
+func (m *InflationSegment) String() string { return proto.CompactTextString(m) }
+func (*InflationSegment) ProtoMessage()    {}
+func (*InflationSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{3}
+}
+func (m *InflationSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSegment.Merge(m, src)
+}
+func (m *InflationSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSegment proto.InternalMessageInfo
+
+func (m *InflationSegment) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *InflationSegment) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *InflationSegment) GetHalving() bool {
+	if m != nil {
+		return m.Halving
+	}
+	return false
+}
+
+// ActiveInflationSegment defines the segment of the inflation schedule in effect
+type ActiveInflationSegment struct {
+	// index of the segment in the schedule, -1 if no segment is active yet
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// the active segment, empty if no segment is active yet
+	Segment *InflationSegment `protobuf:"bytes,2,opt,name=segment,proto3" json:"segment,omitempty"`
+	// inflation rate in effect
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *ActiveInflationSegment) Reset()         { *m = ActiveInflationSegment{} }
+func (m *ActiveInflationSegment) String() string { return proto.CompactTextString(m) }
+func (*ActiveInflationSegment) ProtoMessage()    {}
+func (*ActiveInflationSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{4}
+}
+func (m *ActiveInflationSegment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveInflationSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveInflationSegment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveInflationSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveInflationSegment.Merge(m, src)
+}
+func (m *ActiveInflationSegment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveInflationSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveInflationSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveInflationSegment proto.InternalMessageInfo
+
+func (m *ActiveInflationSegment) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ActiveInflationSegment) GetSegment() *InflationSegment {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
+}
+
+// SupplyHeadroom defines the amount of the mint denom which can still be minted before
+// the total supply reaches the max supply
+type SupplyHeadroom struct {
+	// current total supply of the mint denom
+	Supply types.Coin `protobuf:"bytes,1,opt,name=supply,proto3" json:"supply"`
+	// maximum total supply of the mint denom
+	MaxSupply types.Coin `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply" yaml:"max_supply"`
+	// amount which can still be minted, zero once the max supply is reached
+	Headroom types.Coin `protobuf:"bytes,3,opt,name=headroom,proto3" json:"headroom"`
+}
+
+func (m *SupplyHeadroom) Reset()         { *m = SupplyHeadroom{} }
+func (m *SupplyHeadroom) String() string { return proto.CompactTextString(m) }
+func (*SupplyHeadroom) ProtoMessage()    {}
+func (*SupplyHeadroom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{5}
+}
+func (m *SupplyHeadroom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyHeadroom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyHeadroom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyHeadroom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyHeadroom.Merge(m, src)
+}
+func (m *SupplyHeadroom) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyHeadroom) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyHeadroom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyHeadroom proto.InternalMessageInfo
+
+func (m *SupplyHeadroom) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *SupplyHeadroom) GetMaxSupply() types.Coin {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return types.Coin{}
+}
+
+func (m *SupplyHeadroom) GetHeadroom() types.Coin {
+	if m != nil {
+		return m.Headroom
+	}
+	return types.Coin{}
+}
+
+// MintCheckpoint defines the total amount of coins minted up to a block
+type MintCheckpoint struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// total amount of coins minted up to and including the block
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_minted,json=totalMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_minted" yaml:"total_minted"`
+}
+
+func (m *MintCheckpoint) Reset()         { *m = MintCheckpoint{} }
+func (m *MintCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*MintCheckpoint) ProtoMessage()    {}
+func (*MintCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{6}
+}
+func (m *MintCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintCheckpoint.Merge(m, src)
+}
+func (m *MintCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintCheckpoint proto.InternalMessageInfo
+
+func (m *MintCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintCheckpoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *MintCheckpoint) GetTotalMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return nil
+}
+
+// SupplyBaseline defines the total supply and the total minted coins at genesis, against
+// which the supply delta is checked
+type SupplyBaseline struct {
+	// total supply of the minted denoms at genesis
+	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
+	// total minted coins at genesis
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_minted,json=totalMinted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_minted" yaml:"total_minted"`
+}
+
+func (m *SupplyBaseline) Reset()         { *m = SupplyBaseline{} }
+func (m *SupplyBaseline) String() string { return proto.CompactTextString(m) }
+func (*SupplyBaseline) ProtoMessage()    {}
+func (*SupplyBaseline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1b9fbb701b2a577, []int{7}
+}
+func (m *SupplyBaseline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyBaseline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyBaseline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyBaseline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyBaseline.Merge(m, src)
+}
+func (m *SupplyBaseline) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyBaseline) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyBaseline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyBaseline proto.InternalMessageInfo
+
+func (m *SupplyBaseline) GetSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Supply
+	}
+	return nil
+}
+
+func (m *SupplyBaseline) GetTotalMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("irishub.mint.RecipientType", RecipientType_name, RecipientType_value)
+	proto.RegisterEnum("irishub.mint.InflationMode", InflationMode_name, InflationMode_value)
+	proto.RegisterEnum("irishub.mint.ProvisionMode", ProvisionMode_name, ProvisionMode_value)
+	proto.RegisterType((*Minter)(nil), "irishub.mint.Minter")
+	proto.RegisterType((*Params)(nil), "irishub.mint.Params")
+	proto.RegisterType((*MintRecipient)(nil), "irishub.mint.MintRecipient")
+	proto.RegisterType((*InflationSegment)(nil), "irishub.mint.InflationSegment")
+	proto.RegisterType((*ActiveInflationSegment)(nil), "irishub.mint.ActiveInflationSegment")
+	proto.RegisterType((*SupplyHeadroom)(nil), "irishub.mint.SupplyHeadroom")
+	proto.RegisterType((*MintCheckpoint)(nil), "irishub.mint.MintCheckpoint")
+	proto.RegisterType((*SupplyBaseline)(nil), "irishub.mint.SupplyBaseline")
+}
+
+func init() { proto.RegisterFile("mint/mint.proto", fileDescriptor_e1b9fbb701b2a577) }
+
+var fileDescriptor_e1b9fbb701b2a577 = []byte{
+	// 1425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x16, 0x2d, 0xc7, 0x8f, 0xb1, 0xe5, 0x28, 0xe3, 0xc7, 0xa5, 0x75, 0x13, 0x49, 0x97, 0xc0,
+	0xbd, 0x30, 0x02, 0x84, 0x4a, 0x72, 0x0b, 0x34, 0x48, 0xd0, 0x85, 0x49, 0xc9, 0x89, 0x50, 0xeb,
+	0x01, 0x5a, 0x2e, 0x9a, 0x16, 0x05, 0x43, 0x91, 0x13, 0x89, 0x30, 0xc9, 0x11, 0x48, 0xca, 0x91,
+	0x17, 0xdd, 0x14, 0x28, 0x50, 0x08, 0x5d, 0x64, 0xd1, 0x45, 0x36, 0x02, 0x8a, 0x76, 0xd7, 0x1f,
+	0xd0, 0x4d, 0xff, 0x40, 0x96, 0x59, 0x16, 0x2d, 0xa0, 0x14, 0xc9, 0xae, 0x4b, 0x77, 0x5d, 0xa0,
+	0x98, 0x19, 0x92, 0x22, 0xe5, 0x20, 0x8e, 0xd2, 0x00, 0xdd, 0x24, 0x9a, 0xc3, 0xf3, 0x9d, 0x6f,
+	0xce, 0x37, 0xe7, 0x9c, 0x19, 0x83, 0x8b, 0xb6, 0xe9, 0xf8, 0x25, 0xf2, 0x8f, 0xd8, 0x73, 0xb1,
+	0x8f, 0xe1, 0xaa, 0xe9, 0x9a, 0x5e, 0xb7, 0xdf, 0x16, 0x89, 0x2d, 0xb7, 0xd1, 0xc1, 0x1d, 0x4c,
+	0x3f, 0x94, 0xc8, 0x2f, 0xe6, 0x93, 0xcb, 0xeb, 0xd8, 0xb3, 0xb1, 0x57, 0x6a, 0x6b, 0x1e, 0x2a,
+	0x1d, 0xdf, 0x68, 0x23, 0x5f, 0xbb, 0x51, 0xd2, 0xb1, 0xe9, 0x84, 0xdf, 0x3b, 0x18, 0x77, 0x2c,
+	0x54, 0xa2, 0xab, 0x76, 0xff, 0x61, 0xc9, 0xe8, 0xbb, 0x9a, 0x6f, 0xe2, 0xf0, 0x7b, 0x61, 0xfa,
+	0xbb, 0x6f, 0xda, 0xc8, 0xf3, 0x35, 0xbb, 0xc7, 0x1c, 0x84, 0x6f, 0xd2, 0x60, 0xa1, 0x66, 0x3a,
+	0x3e, 0x72, 0xe1, 0xa7, 0x60, 0xc5, 0xd2, 0x3c, 0x5f, 0xed, 0xf7, 0x0c, 0xcd, 0x47, 0x3c, 0x57,
+	0xe4, 0x76, 0x56, 0x6e, 0xe6, 0x44, 0x16, 0x41, 0x0c, 0x23, 0x88, 0xad, 0x30, 0x82, 0x94, 0x7f,
+	0x3a, 0x2e, 0xa4, 0x4e, 0xc7, 0x05, 0x78, 0xa2, 0xd9, 0xd6, 0x6d, 0x21, 0x06, 0x16, 0x1e, 0x3f,
+	0x2f, 0x70, 0x0a, 0x20, 0x96, 0x43, 0x6a, 0x80, 0x0e, 0x58, 0x33, 0x9d, 0x87, 0x16, 0xdd, 0x9b,
+	0x4a, 0xb2, 0xe1, 0xe7, 0x8a, 0xdc, 0xce, 0xb2, 0x74, 0x97, 0xc4, 0xf8, 0x65, 0x5c, 0xf8, 0x5f,
+	0xc7, 0xf4, 0x89, 0x16, 0x3a, 0xb6, 0x4b, 0x41, 0xce, 0xec, 0xbf, 0x6b, 0x9e, 0x71, 0x54, 0xf2,
+	0x4f, 0x7a, 0xc8, 0x13, 0xab, 0x8e, 0x7f, 0x3a, 0x2e, 0x6c, 0x32, 0xb6, 0x64, 0x34, 0x41, 0xc9,
+	0x44, 0x06, 0x49, 0xf3, 0x10, 0x7c, 0x00, 0x96, 0x23, 0x03, 0x9f, 0xa6, 0x54, 0xd2, 0x0c, 0x54,
+	0x65, 0xa4, 0x9f, 0x8e, 0x0b, 0xd9, 0x29, 0x2a, 0x41, 0x99, 0x04, 0x8d, 0xe4, 0x72, 0x11, 0x4d,
+	0x67, 0xfe, 0xad, 0xe4, 0x62, 0xe0, 0x98, 0x5c, 0x0a, 0x33, 0xfc, 0x04, 0xc0, 0x42, 0x53, 0x73,
+	0x35, 0xdb, 0x83, 0x57, 0x00, 0x20, 0x05, 0xa2, 0x1a, 0xc8, 0xc1, 0x36, 0x3d, 0x95, 0x65, 0x65,
+	0x99, 0x58, 0xca, 0xc4, 0x00, 0xf7, 0xe3, 0x89, 0x32, 0x4d, 0xc5, 0xd9, 0x12, 0x8d, 0x27, 0xf5,
+	0x19, 0x58, 0xeb, 0xb9, 0xf8, 0xd8, 0xf4, 0x88, 0xb0, 0x36, 0x36, 0x10, 0xd5, 0x6e, 0xed, 0xe6,
+	0xbf, 0xc5, 0x78, 0xb1, 0x8a, 0xcd, 0xd0, 0xa7, 0x86, 0x0d, 0x24, 0x6d, 0x4f, 0x4e, 0x25, 0x09,
+	0x16, 0x94, 0x4c, 0x2f, 0xee, 0x09, 0x1d, 0x00, 0x6d, 0x6d, 0xa0, 0xb6, 0x2d, 0xac, 0x1f, 0xa9,
+	0x61, 0xa9, 0x06, 0xd2, 0x6d, 0x9f, 0x91, 0xae, 0x1c, 0x38, 0x48, 0xff, 0x0d, 0x94, 0xdb, 0x66,
+	0x24, 0x67, 0x43, 0x08, 0x4f, 0x88, 0x80, 0x59, 0x5b, 0x1b, 0x48, 0xc4, 0x1e, 0x02, 0x61, 0x0f,
+	0xc0, 0x49, 0x9d, 0x78, 0x7a, 0x17, 0x19, 0x7d, 0x0b, 0xf1, 0x17, 0x8a, 0xe9, 0x9d, 0x95, 0x9b,
+	0xf9, 0x64, 0x4a, 0xd5, 0xd0, 0xef, 0x00, 0x75, 0x6c, 0xe4, 0xf8, 0xd2, 0x7f, 0x92, 0xa4, 0x67,
+	0xe3, 0x08, 0xca, 0xa5, 0xc8, 0x78, 0x10, 0xd8, 0x88, 0x80, 0x13, 0x4f, 0x2a, 0xe0, 0xc2, 0xab,
+	0x04, 0x8c, 0xd8, 0xa6, 0x05, 0x4c, 0x82, 0xe3, 0x65, 0x4d, 0x05, 0xfc, 0x82, 0x03, 0x9b, 0x13,
+	0x17, 0x57, 0xf3, 0x91, 0xaa, 0x77, 0x35, 0xa7, 0x83, 0xf8, 0x45, 0x7a, 0xf4, 0xf5, 0x99, 0x6b,
+	0xfc, 0xf2, 0x34, 0x6f, 0x2c, 0xa8, 0xa0, 0xac, 0x47, 0x76, 0x45, 0xf3, 0x91, 0x4c, 0xad, 0xf0,
+	0x08, 0x64, 0x62, 0xdb, 0xd4, 0x06, 0xfc, 0x12, 0xe5, 0xde, 0x9b, 0x99, 0x7b, 0xe3, 0x4c, 0xce,
+	0xda, 0x40, 0x50, 0x56, 0x27, 0x29, 0x6b, 0x83, 0x29, 0x32, 0xd3, 0xe1, 0x97, 0xdf, 0x19, 0x99,
+	0xe9, 0x24, 0xc8, 0x4c, 0x07, 0x22, 0xb0, 0xd2, 0xc1, 0x9a, 0xa5, 0xb6, 0xb1, 0x63, 0x20, 0x83,
+	0x07, 0x94, 0xaa, 0x3c, 0x33, 0x55, 0xd0, 0xe1, 0xb1, 0x50, 0x82, 0x02, 0xc8, 0x4a, 0xa2, 0x0b,
+	0xb8, 0x0b, 0x80, 0x8b, 0x74, 0xb3, 0x67, 0x22, 0xc7, 0xf7, 0xf8, 0x15, 0x5a, 0x8e, 0x53, 0x05,
+	0x42, 0x66, 0xb2, 0x12, 0xfa, 0x48, 0xf3, 0x64, 0x0b, 0x4a, 0x0c, 0x04, 0xdb, 0x00, 0x90, 0x36,
+	0xf0, 0xfa, 0xbd, 0x9e, 0x75, 0xc2, 0xaf, 0xd2, 0x8d, 0xca, 0x33, 0xcf, 0xd2, 0x4b, 0x93, 0x86,
+	0x62, 0x91, 0x04, 0x65, 0xd9, 0xd6, 0x06, 0x07, 0xf4, 0x37, 0x7c, 0x00, 0x32, 0x6c, 0x3e, 0xa9,
+	0x3d, 0xe4, 0x9a, 0xd8, 0xe0, 0x33, 0xe7, 0x35, 0x6a, 0x31, 0xe8, 0x99, 0x40, 0xeb, 0x04, 0x9a,
+	0xf5, 0xe8, 0x2a, 0xb3, 0x35, 0xa9, 0x09, 0x36, 0xc0, 0xba, 0xde, 0x45, 0xfa, 0x51, 0x0f, 0x93,
+	0x09, 0x47, 0xaf, 0xa1, 0x63, 0xcd, 0xe2, 0xd7, 0x8a, 0xdc, 0xce, 0xbc, 0x94, 0x3f, 0x1d, 0x17,
+	0x72, 0x2c, 0xd0, 0x2b, 0x9c, 0x04, 0x05, 0x4e, 0xac, 0xd5, 0xc0, 0x78, 0x7b, 0xfe, 0xc9, 0xb7,
+	0x85, 0x94, 0xf0, 0x1d, 0x07, 0x32, 0x09, 0x01, 0x61, 0x09, 0xcc, 0x93, 0xbc, 0x79, 0xee, 0x55,
+	0xcd, 0x18, 0xb9, 0xb5, 0x4e, 0x7a, 0x48, 0xa1, 0x8e, 0x90, 0x07, 0x8b, 0x9a, 0x61, 0xb8, 0xc8,
+	0xf3, 0xd8, 0x50, 0x55, 0xc2, 0x25, 0xdc, 0x03, 0x0b, 0x8f, 0x90, 0xd9, 0xe9, 0xfa, 0x7c, 0xfa,
+	0xad, 0xa6, 0x6d, 0x80, 0x16, 0xbe, 0x9e, 0x03, 0xd9, 0xe9, 0xa1, 0x03, 0x6f, 0x83, 0x55, 0xcf,
+	0xd7, 0x5c, 0x5f, 0xed, 0x32, 0x0a, 0xb2, 0xdf, 0xb4, 0xf4, 0xaf, 0xd3, 0x71, 0x61, 0x9d, 0x29,
+	0x11, 0xff, 0x2a, 0x28, 0x2b, 0x74, 0x79, 0x8f, 0xae, 0x60, 0x0b, 0x00, 0xf6, 0x95, 0xdc, 0xf1,
+	0xfc, 0xdc, 0xb9, 0xf7, 0xd1, 0xf6, 0xa4, 0x00, 0x26, 0x38, 0x76, 0x15, 0x2d, 0x53, 0x03, 0x71,
+	0x4d, 0xde, 0x2f, 0xe9, 0xbf, 0x7b, 0xbf, 0xf0, 0x60, 0xb1, 0xab, 0x59, 0xc7, 0xa6, 0xd3, 0xa1,
+	0x53, 0x7f, 0x49, 0x09, 0x97, 0xc2, 0x8f, 0x1c, 0xd8, 0xda, 0xd5, 0x7d, 0xf3, 0x18, 0x9d, 0x11,
+	0x65, 0x03, 0x5c, 0x30, 0x1d, 0x03, 0x0d, 0x98, 0x1a, 0x0a, 0x5b, 0xc0, 0x5b, 0x60, 0xd1, 0x63,
+	0x0e, 0x41, 0xae, 0xe7, 0x0c, 0x74, 0x25, 0x74, 0x7f, 0xb7, 0x29, 0x09, 0xbf, 0x72, 0x60, 0x8d,
+	0x35, 0xcc, 0x3d, 0xa4, 0x19, 0x2e, 0xc6, 0x36, 0x7c, 0x1f, 0x2c, 0x04, 0x8d, 0xc9, 0x05, 0x1d,
+	0xc3, 0x82, 0x88, 0xa4, 0xf4, 0xc5, 0xe0, 0x19, 0x27, 0xca, 0xd8, 0x74, 0x82, 0xce, 0x0e, 0xdc,
+	0xe1, 0x41, 0xa2, 0xab, 0xe7, 0xce, 0x03, 0x6f, 0x07, 0xed, 0xf6, 0xfa, 0x36, 0xbe, 0x03, 0x96,
+	0xba, 0xc1, 0xce, 0xf8, 0xf4, 0x79, 0x21, 0xd9, 0x7e, 0x22, 0x80, 0xf0, 0x3b, 0x07, 0xd6, 0x48,
+	0x2b, 0xc9, 0x51, 0xaf, 0xc1, 0x2d, 0xb0, 0x10, 0xaf, 0x4e, 0x25, 0x58, 0xc1, 0x5b, 0x60, 0xfe,
+	0x0d, 0x2b, 0x6f, 0x89, 0x90, 0xd0, 0x42, 0xa3, 0x08, 0xf8, 0x25, 0x07, 0x56, 0x7d, 0xec, 0x6b,
+	0x16, 0x99, 0xc9, 0x3e, 0x32, 0xf8, 0x74, 0x31, 0xfd, 0xfa, 0x6d, 0xde, 0x0d, 0x32, 0x0f, 0xba,
+	0x22, 0x0e, 0x16, 0x7e, 0x78, 0x5e, 0xd8, 0x79, 0x83, 0x63, 0x24, 0x71, 0x3c, 0x65, 0x85, 0x42,
+	0x6b, 0x0c, 0xf9, 0x67, 0x74, 0x94, 0xe4, 0x0d, 0x69, 0x99, 0x0e, 0x82, 0x7a, 0xec, 0x28, 0xcf,
+	0xd9, 0xd3, 0x75, 0xb2, 0xa7, 0x99, 0xc8, 0xc3, 0x63, 0x3f, 0x93, 0xff, 0xdc, 0x3f, 0x92, 0xff,
+	0xd5, 0x3f, 0x38, 0x90, 0x49, 0x0c, 0x43, 0x78, 0x07, 0x5c, 0x56, 0x2a, 0x72, 0xb5, 0x59, 0xad,
+	0xd4, 0x5b, 0x6a, 0xeb, 0x7e, 0xb3, 0xa2, 0xee, 0x55, 0x2a, 0xaa, 0xdc, 0xd8, 0xdf, 0xaf, 0xc8,
+	0xad, 0x86, 0x92, 0x4d, 0xe5, 0xb6, 0x87, 0xa3, 0xe2, 0x66, 0x04, 0xda, 0x43, 0x48, 0xc6, 0x96,
+	0x85, 0x74, 0x1f, 0xbb, 0xf0, 0x03, 0x70, 0x65, 0x0a, 0x2c, 0x37, 0x6a, 0xb5, 0xc3, 0x7a, 0xb5,
+	0x75, 0x5f, 0x6d, 0x36, 0x1a, 0xfb, 0x59, 0x2e, 0x97, 0x1b, 0x8e, 0x8a, 0x5b, 0x11, 0x5a, 0xc6,
+	0xb6, 0xdd, 0x77, 0x4c, 0xff, 0xa4, 0x89, 0xb1, 0x05, 0x45, 0xb0, 0x39, 0x05, 0xaf, 0x35, 0xca,
+	0x87, 0xfb, 0x95, 0xec, 0x5c, 0x6e, 0x7d, 0x38, 0x2a, 0x5e, 0x8c, 0x60, 0x35, 0x4c, 0x9f, 0x5e,
+	0xd7, 0xc1, 0xd6, 0x94, 0xff, 0xae, 0x2c, 0x37, 0x0e, 0xeb, 0xad, 0x6c, 0x3a, 0xb7, 0x31, 0x1c,
+	0x15, 0xb3, 0x11, 0x60, 0x57, 0xd7, 0x71, 0xdf, 0xf1, 0x73, 0xf3, 0x5f, 0x7d, 0x9f, 0x4f, 0x5d,
+	0xfd, 0x1c, 0x64, 0x12, 0xcf, 0x31, 0x78, 0x1d, 0x6c, 0x54, 0xeb, 0x7b, 0xfb, 0xbb, 0xad, 0x6a,
+	0xa3, 0x4e, 0x38, 0x2b, 0xea, 0x5e, 0xf5, 0xe3, 0x4a, 0x39, 0x9b, 0xca, 0x6d, 0x0d, 0x47, 0x45,
+	0x98, 0x70, 0xde, 0x33, 0x07, 0xc8, 0x80, 0xef, 0x81, 0xad, 0x29, 0x44, 0xf9, 0x7e, 0x7d, 0xb7,
+	0x56, 0x95, 0xb3, 0x5c, 0x8e, 0x1f, 0x8e, 0x8a, 0x1b, 0x09, 0x4c, 0xf9, 0xc4, 0xd1, 0x6c, 0x53,
+	0x0f, 0xe8, 0x1f, 0x81, 0x4c, 0xe2, 0x39, 0x4d, 0xe8, 0x9b, 0x4a, 0xe3, 0xa3, 0xea, 0x41, 0x14,
+	0x4c, 0xda, 0x6f, 0xc8, 0x1f, 0x86, 0xf4, 0xc9, 0xb7, 0x37, 0x79, 0xee, 0x42, 0x11, 0xac, 0x4f,
+	0x21, 0x5a, 0xd5, 0x5a, 0x25, 0xcb, 0xe5, 0x36, 0x87, 0xa3, 0xe2, 0xa5, 0x04, 0x80, 0x34, 0x21,
+	0x23, 0x96, 0xee, 0x3e, 0x7d, 0x91, 0xe7, 0x9e, 0xbd, 0xc8, 0x73, 0xbf, 0xbd, 0xc8, 0x73, 0x8f,
+	0x5f, 0xe6, 0x53, 0xcf, 0x5e, 0xe6, 0x53, 0x3f, 0xbf, 0xcc, 0xa7, 0x3e, 0xb9, 0x16, 0x2b, 0x1f,
+	0x32, 0x53, 0x1d, 0xe4, 0x97, 0x82, 0xd9, 0x5a, 0xb2, 0xa9, 0xd8, 0x1e, 0xfd, 0x43, 0x96, 0x55,
+	0x52, 0x7b, 0x81, 0xb6, 0xf8, 0xff, 0xff, 0x1a, 0x00, 0x87, 0x5d, 0x99, 0x76, 0xe2, 0x0e, 0x00,
+	0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRebase, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRebase):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationBase.Size()
+		i -= size
+		if _, err := m.InflationBase.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMint(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckpointInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.CheckpointInterval))
+		i--
+		dAtA[i] = 0x70
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RebasePeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RebasePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.InflationMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.InflationSchedule) > 0 {
+		for iNdEx := len(m.InflationSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxBlockDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxBlockDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMint(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.ProvisionMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ProvisionMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halving {
+		i--
+		if m.Halving {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StartTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMint(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveInflationSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveInflationSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveInflationSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Segment != nil {
+		{
+			size, err := m.Segment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyHeadroom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyHeadroom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyHeadroom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Headroom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.MaxSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalMinted) > 0 {
+		for iNdEx := len(m.TotalMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintMint(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyBaseline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyBaseline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyBaseline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalMinted) > 0 {
+		for iNdEx := len(m.TotalMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Supply) > 0 {
+		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationBase.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRebase)
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.ProvisionMode != 0 {
+		n += 1 + sovMint(uint64(m.ProvisionMode))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxBlockDuration)
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.InflationSchedule) > 0 {
+		for _, e := range m.InflationSchedule {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.InflationMode != 0 {
+		n += 1 + sovMint(uint64(m.InflationMode))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RebasePeriod)
+	n += 1 + l + sovMint(uint64(l))
+	if m.CheckpointInterval != 0 {
+		n += 1 + sovMint(uint64(m.CheckpointInterval))
+	}
+	return n
+}
+
+func (m *MintRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovMint(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.Halving {
+		n += 2
+	}
+	return n
+}
+
+func (m *ActiveInflationSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovMint(uint64(m.Index))
+	}
+	if m.Segment != nil {
+		l = m.Segment.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *SupplyHeadroom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Supply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Headroom.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MintCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.TotalMinted) > 0 {
+		for _, e := range m.TotalMinted {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyBaseline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if len(m.TotalMinted) > 0 {
+		for _, e := range m.TotalMinted {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRebase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRebase, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionMode", wireType)
+			}
+			m.ProvisionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvisionMode |= ProvisionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxBlockDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSchedule = append(m.InflationSchedule, InflationSegment{})
+			if err := m.InflationSchedule[len(m.InflationSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMode", wireType)
+			}
+			m.InflationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationMode |= InflationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, MintRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebasePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RebasePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointInterval", wireType)
+			}
+			m.CheckpointInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halving", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halving = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveInflationSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveInflationSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveInflationSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Segment == nil {
+				m.Segment = &InflationSegment{}
+			}
+			if err := m.Segment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i = encodeVarintMint(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SupplyHeadroom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyHeadroom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyHeadroom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastUpdate)
-	n += 1 + l + sovMint(uint64(l))
-	l = m.InflationBase.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *MintCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -306,15 +2775,34 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -341,15 +2829,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastUpdate, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -359,23 +2847,23 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalMinted = append(m.TotalMinted, types.Coin{})
+			if err := m.TotalMinted[len(m.TotalMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -403,7 +2891,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SupplyBaseline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -426,17 +2914,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SupplyBaseline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SupplyBaseline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -446,29 +2934,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			m.Supply = append(m.Supply, types.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -478,23 +2968,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalMinted = append(m.TotalMinted, types.Coin{})
+			if err := m.TotalMinted[len(m.TotalMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
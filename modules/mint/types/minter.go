@@ -8,25 +8,37 @@ import (
 )
 
 const (
-	blocksPerYear = 60 * 60 * 8766 / 5 // 5 second a block, 8766 = 365.25 * 24
+	secondsPerYear = 60 * 60 * 8766     // 8766 = 365.25 * 24
+	blocksPerYear  = secondsPerYear / 5 // 5 second a block
+	blocksPerDay   = 60 * 60 * 24 / 5
+
+	// ExpectedBlockInterval is the block interval assumed when estimating the provisions of the next block
+	ExpectedBlockInterval = 5 * time.Second
 )
 
-var initialIssue = sdk.NewIntWithDecimal(20, 8)
+var (
+	initialIssue = sdk.NewIntWithDecimal(20, 8)
+	maxIssue     = sdk.NewIntWithDecimal(100, 8)
+)
 
-// Create a new minter object
+// Create a new minter object, whose inflation base is considered rebased at the last update
 func NewMinter(lastUpdate time.Time, inflationBase sdk.Int) Minter {
 	return Minter{
 		LastUpdate:    lastUpdate,
 		InflationBase: inflationBase,
+		Inflation:     sdk.ZeroDec(),
+		LastRebase:    lastUpdate,
 	}
 }
 
 // DefaultMinter returns minter object for a new chain
 func DefaultMinter() Minter {
-	return NewMinter(
+	minter := NewMinter(
 		time.Unix(0, 0).UTC(),
 		initialIssue.Mul(sdk.NewIntWithDecimal(1, 6)), // 20*(10^8)iris, 20*(10^8)*(10^6)uiris
 	)
+	minter.Inflation = DefaultParams().Inflation
+	return minter
 }
 
 // ValidateMinter returns err if the Minter is invalid
@@ -37,17 +49,90 @@ func ValidateMinter(m Minter) error {
 	if !m.InflationBase.GT(sdk.ZeroInt()) {
 		return fmt.Errorf("minter inflation basement (%s) should be positive", m.InflationBase.String())
 	}
+	if !m.Inflation.IsNil() && m.Inflation.IsNegative() {
+		return fmt.Errorf("minter inflation (%s) should not be negative", m.Inflation.String())
+	}
 	return nil
 }
 
+// IsRebaseDue returns true if rebasing is enabled and the rebase period has elapsed since
+// the last rebase at the given block time
+func (m Minter) IsRebaseDue(params Params, blockTime time.Time) bool {
+	return params.RebasePeriod > 0 && !blockTime.Before(m.LastRebase.Add(params.RebasePeriod))
+}
+
+// Rebase returns the minter with the inflation base set to the given total supply at the given block time
+func (m Minter) Rebase(supply sdk.Int, blockTime time.Time) Minter {
+	m.InflationBase = supply
+	m.LastRebase = blockTime
+	return m
+}
+
 // NextAnnualProvisions gets the provisions for a block based on the annual provisions rate
 func (m Minter) NextAnnualProvisions(params Params) (provisions sdk.Dec) {
 	return params.Inflation.MulInt(m.InflationBase)
 }
 
-// BlockProvision gets the provisions for a block based on the annual provisions rate
-func (m Minter) BlockProvision(params Params) sdk.Coin {
+// NextInflationRate returns the inflation rate of the minter moved toward the target bonded
+// ratio in proportion to the time span of the block, and bounded by the min and max params
+func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec, blockTime time.Time) sdk.Dec {
+	inflation := m.Inflation
+	if inflation.IsNil() {
+		inflation = sdk.ZeroDec()
+	}
+
+	// the rate increases while the bonded ratio is below the goal and decreases otherwise
+	changePerYear := sdk.OneDec().Sub(bondedRatio.Quo(params.GoalBonded)).Mul(params.InflationRateChange)
+
+	switch params.ProvisionMode {
+	case ProvisionModeTime:
+		elapsed := m.ElapsedTime(params, blockTime)
+		inflation = inflation.Add(changePerYear.MulInt64(int64(elapsed)).QuoInt64(int64(secondsPerYear * time.Second)))
+	default:
+		inflation = inflation.Add(changePerYear.QuoInt64(blocksPerYear))
+	}
+
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+	return inflation
+}
+
+// BlockProvision gets the provisions for a block based on the annual provisions rate.
+// In the time mode the provisions are proportional to the time elapsed between the last
+// update and the given block time, which is capped by the MaxBlockDuration param
+func (m Minter) BlockProvision(params Params, blockTime time.Time) sdk.Coin {
 	provisions := m.NextAnnualProvisions(params)
-	blockInflationAmount := provisions.QuoInt(sdk.NewInt(blocksPerYear))
+
+	var blockInflationAmount sdk.Dec
+	switch params.ProvisionMode {
+	case ProvisionModeTime:
+		elapsed := m.ElapsedTime(params, blockTime)
+		blockInflationAmount = provisions.MulInt64(int64(elapsed)).QuoInt64(int64(secondsPerYear * time.Second))
+	default:
+		blockInflationAmount = provisions.QuoInt(sdk.NewInt(blocksPerYear))
+	}
 	return sdk.NewCoin(params.MintDenom, blockInflationAmount.TruncateInt())
 }
+
+// NextBlockProvision estimates the provisions of the next block, which is assumed to be
+// committed ExpectedBlockInterval after the last update
+func (m Minter) NextBlockProvision(params Params) sdk.Coin {
+	return m.BlockProvision(params, m.LastUpdate.Add(ExpectedBlockInterval))
+}
+
+// ElapsedTime returns the time span minted for at the given block time, which is never
+// negative and never exceeds the MaxBlockDuration param
+func (m Minter) ElapsedTime(params Params, blockTime time.Time) time.Duration {
+	elapsed := blockTime.Sub(m.LastUpdate)
+	if elapsed < 0 {
+		return 0
+	}
+	if elapsed > params.MaxBlockDuration {
+		return params.MaxBlockDuration
+	}
+	return elapsed
+}
@@ -9,8 +9,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+var (
+	rateChange    = sdk.NewDecWithPrec(13, 2)
+	inflationMax  = sdk.NewDecWithPrec(20, 2)
+	inflationMin  = sdk.NewDecWithPrec(7, 2)
+	goalBonded    = sdk.NewDecWithPrec(67, 2)
+	maxSupply     = sdk.NewIntWithDecimal(1, 16)
+	dynamicParams = NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeDynamic, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)
+)
+
 func TestNextInflation(t *testing.T) {
-	minter := NewMinter(time.Now(), sdk.NewIntWithDecimal(100, 18))
+	now := time.Now()
+	minter := NewMinter(now, sdk.NewIntWithDecimal(100, 18))
 	tests := []struct{ params Params }{
 		{Params{Inflation: sdk.NewDecWithPrec(20, 2), MintDenom: sdk.DefaultBondDenom}},
 		{Params{Inflation: sdk.NewDecWithPrec(10, 2), MintDenom: sdk.DefaultBondDenom}},
@@ -18,12 +28,155 @@ func TestNextInflation(t *testing.T) {
 	}
 	for _, tc := range tests {
 		annualProvisions := minter.NextAnnualProvisions(tc.params)
-		mintCoin := minter.BlockProvision(tc.params)
+		mintCoin := minter.BlockProvision(tc.params, now.Add(time.Hour))
 		blockProvision := annualProvisions.QuoInt(sdk.NewInt(12 * 60 * 8766))
 		require.True(t, mintCoin.Amount.Equal(blockProvision.TruncateInt()), "mint amount:"+mintCoin.Amount.String()+", block provision amount: "+blockProvision.TruncateInt().String())
 	}
 }
 
+func TestTimeBasedBlockProvision(t *testing.T) {
+	lastUpdate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter := NewMinter(lastUpdate, sdk.NewIntWithDecimal(100, 18))
+	params := NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)
+
+	annualProvisions := minter.NextAnnualProvisions(params)
+	provisionFor := func(d time.Duration) sdk.Int {
+		return annualProvisions.MulInt64(int64(d)).QuoInt64(int64(secondsPerYear * time.Second)).TruncateInt()
+	}
+
+	tests := []struct {
+		name      string
+		blockTime time.Time
+		expected  sdk.Int
+	}{
+		{"no time elapsed", lastUpdate, sdk.ZeroInt()},
+		{"block time before last update", lastUpdate.Add(-10 * time.Second), sdk.ZeroInt()},
+		{"regular block", lastUpdate.Add(5 * time.Second), provisionFor(5 * time.Second)},
+		{"fast block", lastUpdate.Add(2 * time.Second), provisionFor(2 * time.Second)},
+		{"slow block", lastUpdate.Add(13 * time.Second), provisionFor(13 * time.Second)},
+		{"sub-second skew", lastUpdate.Add(5*time.Second + 300*time.Millisecond), provisionFor(5*time.Second + 300*time.Millisecond)},
+		{"at the cap", lastUpdate.Add(time.Minute), provisionFor(time.Minute)},
+		{"halt", lastUpdate.Add(48 * time.Hour), provisionFor(time.Minute)},
+	}
+	for _, tc := range tests {
+		mintCoin := minter.BlockProvision(params, tc.blockTime)
+		require.Equal(t, sdk.DefaultBondDenom, mintCoin.Denom, tc.name)
+		require.True(t, tc.expected.Equal(mintCoin.Amount), "%s: expected %s, got %s", tc.name, tc.expected, mintCoin.Amount)
+	}
+
+	require.Equal(t, minter.BlockProvision(params, lastUpdate.Add(ExpectedBlockInterval)), minter.NextBlockProvision(params))
+
+	// a regular block mints the same amount in both modes
+	blockParams := params
+	blockParams.ProvisionMode = ProvisionModeBlock
+	require.Equal(t,
+		minter.BlockProvision(blockParams, lastUpdate.Add(48*time.Hour)),
+		minter.BlockProvision(params, lastUpdate.Add(5*time.Second)),
+	)
+}
+
+func TestNextInflationRate(t *testing.T) {
+	lastUpdate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockTime := lastUpdate.Add(5 * time.Second)
+	blockParams := dynamicParams
+	blockParams.ProvisionMode = ProvisionModeBlock
+
+	// the change of a regular 5 second block at the given bonded ratio
+	changeFor := func(bondedRatio sdk.Dec) sdk.Dec {
+		return sdk.OneDec().Sub(bondedRatio.Quo(goalBonded)).Mul(rateChange).MulInt64(int64(5 * time.Second)).QuoInt64(int64(secondsPerYear * time.Second))
+	}
+
+	tests := []struct {
+		name        string
+		inflation   sdk.Dec
+		bondedRatio sdk.Dec
+		params      Params
+		expected    sdk.Dec
+	}{
+		{"at the goal", sdk.NewDecWithPrec(10, 2), goalBonded, dynamicParams, sdk.NewDecWithPrec(10, 2)},
+		{"below the goal", sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(50, 2), dynamicParams, sdk.NewDecWithPrec(10, 2).Add(changeFor(sdk.NewDecWithPrec(50, 2)))},
+		{"above the goal", sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(80, 2), dynamicParams, sdk.NewDecWithPrec(10, 2).Add(changeFor(sdk.NewDecWithPrec(80, 2)))},
+		{"capped at max", inflationMax, sdk.ZeroDec(), dynamicParams, inflationMax},
+		{"floored at min", inflationMin, sdk.OneDec(), dynamicParams, inflationMin},
+		{"unset rate starts at min", sdk.Dec{}, sdk.NewDecWithPrec(50, 2), dynamicParams, inflationMin},
+		{"block mode", sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(50, 2), blockParams, sdk.NewDecWithPrec(10, 2).Add(changeFor(sdk.NewDecWithPrec(50, 2)))},
+	}
+	for _, tc := range tests {
+		minter := NewMinter(lastUpdate, sdk.NewIntWithDecimal(100, 18))
+		minter.Inflation = tc.inflation
+		inflation := minter.NextInflationRate(tc.params, tc.bondedRatio, blockTime)
+		require.True(t, tc.expected.Equal(inflation), "%s: expected %s, got %s", tc.name, tc.expected, inflation)
+	}
+
+	// a halt moves the rate by the max block duration only
+	minter := NewMinter(lastUpdate, sdk.NewIntWithDecimal(100, 18))
+	minter.Inflation = sdk.NewDecWithPrec(10, 2)
+	require.Equal(t,
+		minter.NextInflationRate(dynamicParams, sdk.NewDecWithPrec(50, 2), lastUpdate.Add(time.Minute)),
+		minter.NextInflationRate(dynamicParams, sdk.NewDecWithPrec(50, 2), lastUpdate.Add(48*time.Hour)),
+	)
+}
+
+func TestRebase(t *testing.T) {
+	lastRebase := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	minter := NewMinter(lastRebase, sdk.NewIntWithDecimal(20, 8))
+	require.Equal(t, lastRebase, minter.LastRebase)
+
+	params := DefaultParams()
+	require.False(t, minter.IsRebaseDue(params, lastRebase.Add(100*8766*time.Hour)), "rebasing disabled")
+
+	params.RebasePeriod = 8766 * time.Hour
+	require.False(t, minter.IsRebaseDue(params, lastRebase))
+	require.False(t, minter.IsRebaseDue(params, lastRebase.Add(params.RebasePeriod-time.Second)))
+	require.True(t, minter.IsRebaseDue(params, lastRebase.Add(params.RebasePeriod)))
+	require.True(t, minter.IsRebaseDue(params, lastRebase.Add(2*params.RebasePeriod)))
+
+	rebaseTime := lastRebase.Add(params.RebasePeriod + time.Minute)
+	rebased := minter.Rebase(sdk.NewIntWithDecimal(21, 8), rebaseTime)
+	require.Equal(t, sdk.NewIntWithDecimal(21, 8), rebased.InflationBase)
+	require.Equal(t, rebaseTime, rebased.LastRebase)
+	require.Equal(t, minter.LastUpdate, rebased.LastUpdate)
+	require.False(t, rebased.IsRebaseDue(params, lastRebase.Add(2*params.RebasePeriod)))
+
+	// the provisions follow the new base
+	require.True(t, rebased.NextAnnualProvisions(params).GT(minter.NextAnnualProvisions(params)))
+}
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expectPass bool
+		params     Params
+	}{
+		{"default", true, DefaultParams()},
+		{"block mode", true, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeBlock, time.Minute, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"invalid inflation", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(3, 1), ProvisionModeTime, time.Minute, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"empty denom", false, NewParams("", sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"invalid provision mode", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionMode(2), time.Minute, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"zero max block duration", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, 0, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"negative max block duration", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, -time.Second, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"dynamic mode", true, dynamicParams},
+		{"rebase period", true, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 8766*time.Hour, 0)},
+		{"negative rebase period", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, -time.Hour, 0)},
+		{"zero max supply", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeFixed, rateChange, inflationMax, inflationMin, goalBonded, nil, sdk.ZeroInt(), 0, 0)},
+		{"invalid inflation mode", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationMode(2), rateChange, inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"negative rate change", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeDynamic, sdk.NewDecWithPrec(-1, 2), inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"rate change above one", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeDynamic, sdk.NewDecWithPrec(11, 1), inflationMax, inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"max above the inflation limit", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeDynamic, rateChange, sdk.NewDecWithPrec(3, 1), inflationMin, goalBonded, nil, maxSupply, 0, 0)},
+		{"negative min", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeDynamic, rateChange, inflationMax, sdk.NewDecWithPrec(-1, 2), goalBonded, nil, maxSupply, 0, 0)},
+		{"min above max", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeDynamic, rateChange, inflationMin, inflationMax, goalBonded, nil, maxSupply, 0, 0)},
+		{"zero goal bonded", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeDynamic, rateChange, inflationMax, inflationMin, sdk.ZeroDec(), nil, maxSupply, 0, 0)},
+		{"goal bonded above one", false, NewParams(sdk.DefaultBondDenom, sdk.NewDecWithPrec(4, 2), ProvisionModeTime, time.Minute, nil, InflationModeDynamic, rateChange, inflationMax, inflationMin, sdk.NewDecWithPrec(11, 1), nil, maxSupply, 0, 0)},
+	}
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.params.Validate(), tc.name)
+		} else {
+			require.Error(t, tc.params.Validate(), tc.name)
+		}
+	}
+}
+
 func TestDefaultMinter(t *testing.T) {
 	err := ValidateMinter(DefaultMinter())
 	require.NoError(t, err)
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -21,8 +22,20 @@ const (
 //Parameter store key
 var (
 	// params store for inflation params
-	KeyInflation = []byte("Inflation")
-	KeyMintDenom = []byte("MintDenom")
+	KeyInflation           = []byte("Inflation")
+	KeyMintDenom           = []byte("MintDenom")
+	KeyProvisionMode       = []byte("ProvisionMode")
+	KeyMaxBlockDuration    = []byte("MaxBlockDuration")
+	KeyInflationSchedule   = []byte("InflationSchedule")
+	KeyInflationMode       = []byte("InflationMode")
+	KeyInflationRateChange = []byte("InflationRateChange")
+	KeyInflationMax        = []byte("InflationMax")
+	KeyInflationMin        = []byte("InflationMin")
+	KeyGoalBonded          = []byte("GoalBonded")
+	KeyRecipients          = []byte("Recipients")
+	KeyMaxSupply           = []byte("MaxSupply")
+	KeyRebasePeriod        = []byte("RebasePeriod")
+	KeyCheckpointInterval  = []byte("CheckpointInterval")
 )
 
 // ParamTable for mint module
@@ -30,18 +43,52 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintDenom string, inflation sdk.Dec) Params {
+func NewParams(
+	mintDenom string,
+	inflation sdk.Dec,
+	provisionMode ProvisionMode,
+	maxBlockDuration time.Duration,
+	inflationSchedule []InflationSegment,
+	inflationMode InflationMode,
+	inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec,
+	recipients []MintRecipient,
+	maxSupply sdk.Int,
+	rebasePeriod time.Duration,
+	checkpointInterval uint64,
+) Params {
 	return Params{
-		MintDenom: mintDenom,
-		Inflation: inflation,
+		MintDenom:           mintDenom,
+		Inflation:           inflation,
+		ProvisionMode:       provisionMode,
+		MaxBlockDuration:    maxBlockDuration,
+		InflationSchedule:   inflationSchedule,
+		InflationMode:       inflationMode,
+		InflationRateChange: inflationRateChange,
+		InflationMax:        inflationMax,
+		InflationMin:        inflationMin,
+		GoalBonded:          goalBonded,
+		Recipients:          recipients,
+		MaxSupply:           maxSupply,
+		RebasePeriod:        rebasePeriod,
+		CheckpointInterval:  checkpointInterval,
 	}
 }
 
 // DefaultParams returns default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		Inflation: sdk.NewDecWithPrec(4, 2),
-		MintDenom: MintDenom,
+		Inflation:           sdk.NewDecWithPrec(4, 2),
+		MintDenom:           MintDenom,
+		ProvisionMode:       ProvisionModeTime,
+		MaxBlockDuration:    time.Minute,
+		InflationMode:       InflationModeFixed,
+		InflationRateChange: sdk.NewDecWithPrec(13, 2),
+		InflationMax:        sdk.NewDecWithPrec(20, 2),
+		InflationMin:        sdk.NewDecWithPrec(7, 2),
+		GoalBonded:          sdk.NewDecWithPrec(67, 2),
+		Recipients:          DefaultMintRecipients(),
+		MaxSupply:           maxIssue.Mul(sdk.NewIntWithDecimal(1, 6)), // 100*(10^8)iris, 100*(10^8)*(10^6)uiris
+		CheckpointInterval:  blocksPerDay,
 	}
 }
 
@@ -56,6 +103,18 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyInflation, &p.Inflation, validateInflation),
 		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
+		paramtypes.NewParamSetPair(KeyProvisionMode, &p.ProvisionMode, validateProvisionMode),
+		paramtypes.NewParamSetPair(KeyMaxBlockDuration, &p.MaxBlockDuration, validateMaxBlockDuration),
+		paramtypes.NewParamSetPair(KeyInflationSchedule, &p.InflationSchedule, validateInflationSchedule),
+		paramtypes.NewParamSetPair(KeyInflationMode, &p.InflationMode, validateInflationMode),
+		paramtypes.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		paramtypes.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflation),
+		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflation),
+		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		paramtypes.NewParamSetPair(KeyRecipients, &p.Recipients, validateMintRecipients),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyRebasePeriod, &p.RebasePeriod, validateRebasePeriod),
+		paramtypes.NewParamSetPair(KeyCheckpointInterval, &p.CheckpointInterval, validateCheckpointInterval),
 	}
 }
 
@@ -72,6 +131,42 @@ func (p Params) Validate() error {
 	if len(p.MintDenom) == 0 {
 		return sdkerrors.Wrapf(ErrInvalidMintDenom, "Mint denom [%s] should not be empty", p.MintDenom)
 	}
+	if err := validateProvisionMode(p.ProvisionMode); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProvisionMode, err.Error())
+	}
+	if err := validateMaxBlockDuration(p.MaxBlockDuration); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMaxBlockDuration, err.Error())
+	}
+	if err := ValidateInflationSchedule(p.InflationSchedule); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInflationSchedule, err.Error())
+	}
+	if err := validateInflationMode(p.InflationMode); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDynamicInflation, err.Error())
+	}
+	if err := validateInflationRateChange(p.InflationRateChange); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDynamicInflation, err.Error())
+	}
+	if err := validateInflation(p.InflationMax); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDynamicInflation, "max %s", err.Error())
+	}
+	if err := validateInflation(p.InflationMin); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDynamicInflation, "min %s", err.Error())
+	}
+	if p.InflationMax.LT(p.InflationMin) {
+		return sdkerrors.Wrapf(ErrInvalidDynamicInflation, "max inflation (%s) must be greater than or equal to min inflation (%s)", p.InflationMax, p.InflationMin)
+	}
+	if err := validateGoalBonded(p.GoalBonded); err != nil {
+		return sdkerrors.Wrap(ErrInvalidDynamicInflation, err.Error())
+	}
+	if err := ValidateMintRecipients(p.Recipients); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMintRecipients, err.Error())
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMaxSupply, err.Error())
+	}
+	if err := validateRebasePeriod(p.RebasePeriod); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRebasePeriod, err.Error())
+	}
 	return nil
 }
 
@@ -81,6 +176,10 @@ func validateInflation(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v.IsNil() {
+		return fmt.Errorf("Mint inflation must be not nil")
+	}
+
 	if v.GT(sdk.NewDecWithPrec(2, 1)) || v.LT(sdk.ZeroDec()) {
 		return fmt.Errorf("Mint inflation [%s] should be between [0, 0.2] ", v.String())
 	}
@@ -103,3 +202,126 @@ func validateMintDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateProvisionMode(i interface{}) error {
+	v, ok := i.(ProvisionMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ProvisionMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid provision mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxBlockDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max block duration must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.([]InflationSegment)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateInflationSchedule(v)
+}
+
+func validateInflationMode(i interface{}) error {
+	v, ok := i.(InflationMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("inflation rate change must be not nil")
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("inflation rate change (%s) should be between [0, 1]", v)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("goal bonded must be not nil")
+	}
+	if !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("goal bonded (%s) should be between (0, 1]", v)
+	}
+
+	return nil
+}
+
+func validateMintRecipients(i interface{}) error {
+	v, ok := i.([]MintRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateMintRecipients(v)
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("max supply (%s) should be positive", v)
+	}
+
+	return nil
+}
+
+func validateRebasePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("rebase period (%s) should not be negative", v)
+	}
+
+	return nil
+}
+
+func validateCheckpointInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -120,229 +121,3286 @@ func (m *QueryParamsResponse) GetRes() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "irishub.mint.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "irishub.mint.QueryParamsResponse")
+// QueryMinterRequest is request type for the Query/Minter RPC method
+type QueryMinterRequest struct {
 }
 
-func init() { proto.RegisterFile("mint/query.proto", fileDescriptor_3082aecef156f565) }
-
-var fileDescriptor_3082aecef156f565 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0x33, 0x31,
-	0x10, 0xc7, 0x77, 0xfb, 0x7d, 0xf6, 0x10, 0x05, 0x25, 0x2e, 0x52, 0x96, 0x1a, 0x6b, 0x4f, 0x1e,
-	0x34, 0x81, 0xfa, 0x06, 0xbd, 0x78, 0xad, 0x3d, 0x7a, 0x4b, 0x6b, 0x88, 0xc1, 0x6e, 0x26, 0xdd,
-	0x64, 0x91, 0x5e, 0x7d, 0x02, 0xc1, 0x97, 0xea, 0xb1, 0xe0, 0xc5, 0x93, 0xc8, 0xae, 0x0f, 0x22,
-	0x49, 0x56, 0xb4, 0x08, 0x5e, 0x42, 0xf8, 0xe7, 0x37, 0xbf, 0xcc, 0x0c, 0x3a, 0x28, 0x94, 0x76,
-	0x6c, 0x59, 0x89, 0x72, 0x45, 0x4d, 0x09, 0x0e, 0xf0, 0x9e, 0x2a, 0x95, 0xbd, 0xab, 0x66, 0xd4,
-	0xbf, 0xe4, 0xc7, 0x73, 0xb0, 0x05, 0xd8, 0x48, 0x30, 0xc3, 0xa5, 0xd2, 0xdc, 0x29, 0xd0, 0x11,
-	0xce, 0xf7, 0x43, 0xb9, 0x3f, 0xda, 0x20, 0x93, 0x20, 0x21, 0x5c, 0x99, 0xbf, 0xb5, 0x69, 0x5f,
-	0x02, 0xc8, 0x85, 0x60, 0xdc, 0x28, 0xc6, 0xb5, 0x06, 0x17, 0x1c, 0x36, 0xbe, 0x0e, 0x33, 0x84,
-	0xaf, 0xbd, 0x7e, 0xc2, 0x4b, 0x5e, 0xd8, 0xa9, 0x58, 0x56, 0xc2, 0xba, 0xe1, 0x03, 0x3a, 0xdc,
-	0x4a, 0xad, 0x01, 0x6d, 0x05, 0x1e, 0xa1, 0xae, 0x09, 0x49, 0x2f, 0x1d, 0xa4, 0x67, 0xbb, 0xa3,
-	0x8c, 0xfe, 0xec, 0x97, 0x46, 0x7a, 0xfc, 0x7f, 0xfd, 0x76, 0x92, 0x4c, 0x5b, 0x12, 0x9f, 0xa3,
-	0x7f, 0xa5, 0xb0, 0xbd, 0x4e, 0x28, 0xc8, 0x69, 0x1c, 0x89, 0xc6, 0xa1, 0x27, 0x5c, 0x8a, 0x2f,
-	0xf9, 0xd4, 0x63, 0x23, 0x87, 0x76, 0xc2, 0xc7, 0xf8, 0x1e, 0x75, 0xa3, 0x0e, 0x0f, 0xb6, 0x3f,
-	0xf9, 0xdd, 0x6d, 0x7e, 0xfa, 0x07, 0x11, 0xe5, 0xc3, 0xfe, 0xe3, 0xcb, 0xc7, 0x73, 0xe7, 0x08,
-	0x67, 0xac, 0x45, 0xc3, 0xde, 0x58, 0xec, 0x71, 0x7c, 0xb5, 0xae, 0x49, 0xba, 0xa9, 0x49, 0xfa,
-	0x5e, 0x93, 0xf4, 0xa9, 0x21, 0xc9, 0xa6, 0x21, 0xc9, 0x6b, 0x43, 0x92, 0x9b, 0x0b, 0xa9, 0x9c,
-	0x17, 0xcf, 0xa1, 0x08, 0x95, 0x5a, 0xb8, 0x6f, 0x03, 0xdc, 0x56, 0x0b, 0x61, 0xa3, 0xc9, 0xad,
-	0x8c, 0xb0, 0xb3, 0x6e, 0x58, 0xea, 0xe5, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0xa6, 0xdd,
-	0x29, 0xda, 0x01, 0x00, 0x00,
+func (m *QueryMinterRequest) Reset()         { *m = QueryMinterRequest{} }
+func (m *QueryMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterRequest) ProtoMessage()    {}
+func (*QueryMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{2}
+}
+func (m *QueryMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterRequest.Merge(m, src)
+}
+func (m *QueryMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryMinterRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryMinterResponse is response type for the Query/Minter RPC method
+type QueryMinterResponse struct {
+	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the mint parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryMinterResponse) Reset()         { *m = QueryMinterResponse{} }
+func (m *QueryMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterResponse) ProtoMessage()    {}
+func (*QueryMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{3}
+}
+func (m *QueryMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterResponse.Merge(m, src)
+}
+func (m *QueryMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryMinterResponse proto.InternalMessageInfo
+
+func (m *QueryMinterResponse) GetMinter() Minter {
+	if m != nil {
+		return m.Minter
+	}
+	return Minter{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryAnnualProvisionsRequest is request type for the Query/AnnualProvisions RPC method
+type QueryAnnualProvisionsRequest struct {
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/irishub.mint.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAnnualProvisionsRequest) Reset()         { *m = QueryAnnualProvisionsRequest{} }
+func (m *QueryAnnualProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsRequest) ProtoMessage()    {}
+func (*QueryAnnualProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{4}
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the mint parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryAnnualProvisionsRequest proto.InternalMessageInfo
+
+// QueryAnnualProvisionsResponse is response type for the Query/AnnualProvisions RPC method
+type QueryAnnualProvisionsResponse struct {
+	AnnualProvisions types.DecCoin `protobuf:"bytes,1,opt,name=annual_provisions,json=annualProvisions,proto3" json:"annual_provisions" yaml:"annual_provisions"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAnnualProvisionsResponse) Reset()         { *m = QueryAnnualProvisionsResponse{} }
+func (m *QueryAnnualProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAnnualProvisionsResponse) ProtoMessage()    {}
+func (*QueryAnnualProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{5}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAnnualProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.Merge(m, src)
+}
+func (m *QueryAnnualProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnnualProvisionsResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
+
+func (m *QueryAnnualProvisionsResponse) GetAnnualProvisions() types.DecCoin {
+	if m != nil {
+		return m.AnnualProvisions
+	}
+	return types.DecCoin{}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryInflationScheduleRequest is request type for the Query/InflationSchedule RPC method
+type QueryInflationScheduleRequest struct {
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryInflationScheduleRequest) Reset()         { *m = QueryInflationScheduleRequest{} }
+func (m *QueryInflationScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleRequest) ProtoMessage()    {}
+func (*QueryInflationScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{6}
+}
+func (m *QueryInflationScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryInflationScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleRequest.Merge(m, src)
+}
+func (m *QueryInflationScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationScheduleRequest proto.InternalMessageInfo
+
+// QueryInflationScheduleResponse is response type for the Query/InflationSchedule RPC method
+type QueryInflationScheduleResponse struct {
+	Schedule []InflationSegment `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryInflationScheduleResponse) Reset()         { *m = QueryInflationScheduleResponse{} }
+func (m *QueryInflationScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationScheduleResponse) ProtoMessage()    {}
+func (*QueryInflationScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{7}
+}
+func (m *QueryInflationScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/irishub.mint.Query/Params",
+}
+func (m *QueryInflationScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationScheduleResponse.Merge(m, src)
+}
+func (m *QueryInflationScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryInflationScheduleResponse) GetSchedule() []InflationSegment {
+	if m != nil {
+		return m.Schedule
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+// QueryActiveInflationSegmentRequest is request type for the Query/ActiveInflationSegment RPC method
+type QueryActiveInflationSegmentRequest struct {
+}
+
+func (m *QueryActiveInflationSegmentRequest) Reset()         { *m = QueryActiveInflationSegmentRequest{} }
+func (m *QueryActiveInflationSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveInflationSegmentRequest) ProtoMessage()    {}
+func (*QueryActiveInflationSegmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{8}
+}
+func (m *QueryActiveInflationSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveInflationSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveInflationSegmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryActiveInflationSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveInflationSegmentRequest.Merge(m, src)
+}
+func (m *QueryActiveInflationSegmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveInflationSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveInflationSegmentRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "irishub.mint.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mint/query.proto",
+var xxx_messageInfo_QueryActiveInflationSegmentRequest proto.InternalMessageInfo
+
+// QueryActiveInflationSegmentResponse is response type for the Query/ActiveInflationSegment RPC method
+type QueryActiveInflationSegmentResponse struct {
+	ActiveSegment ActiveInflationSegment `protobuf:"bytes,1,opt,name=active_segment,json=activeSegment,proto3" json:"active_segment" yaml:"active_segment"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryActiveInflationSegmentResponse) Reset()         { *m = QueryActiveInflationSegmentResponse{} }
+func (m *QueryActiveInflationSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveInflationSegmentResponse) ProtoMessage()    {}
+func (*QueryActiveInflationSegmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{9}
+}
+func (m *QueryActiveInflationSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveInflationSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveInflationSegmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryActiveInflationSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveInflationSegmentResponse.Merge(m, src)
+}
+func (m *QueryActiveInflationSegmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveInflationSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveInflationSegmentResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryActiveInflationSegmentResponse proto.InternalMessageInfo
+
+func (m *QueryActiveInflationSegmentResponse) GetActiveSegment() ActiveInflationSegment {
+	if m != nil {
+		return m.ActiveSegment
+	}
+	return ActiveInflationSegment{}
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryBlockProvisionRequest is request type for the Query/BlockProvision RPC method
+type QueryBlockProvisionRequest struct {
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBlockProvisionRequest) Reset()         { *m = QueryBlockProvisionRequest{} }
+func (m *QueryBlockProvisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProvisionRequest) ProtoMessage()    {}
+func (*QueryBlockProvisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{10}
+}
+func (m *QueryBlockProvisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProvisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProvisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryBlockProvisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProvisionRequest.Merge(m, src)
+}
+func (m *QueryBlockProvisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProvisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProvisionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryBlockProvisionRequest proto.InternalMessageInfo
+
+// QueryBlockProvisionResponse is response type for the Query/BlockProvision RPC method
+type QueryBlockProvisionResponse struct {
+	BlockProvision types.Coin `protobuf:"bytes,1,opt,name=block_provision,json=blockProvision,proto3" json:"block_provision" yaml:"block_provision"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+func (m *QueryBlockProvisionResponse) Reset()         { *m = QueryBlockProvisionResponse{} }
+func (m *QueryBlockProvisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProvisionResponse) ProtoMessage()    {}
+func (*QueryBlockProvisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{11}
+}
+func (m *QueryBlockProvisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProvisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProvisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProvisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProvisionResponse.Merge(m, src)
+}
+func (m *QueryBlockProvisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProvisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProvisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProvisionResponse proto.InternalMessageInfo
+
+func (m *QueryBlockProvisionResponse) GetBlockProvision() types.Coin {
+	if m != nil {
+		return m.BlockProvision
+	}
+	return types.Coin{}
+}
+
+// QuerySupplyHeadroomRequest is request type for the Query/SupplyHeadroom RPC method
+type QuerySupplyHeadroomRequest struct {
+}
+
+func (m *QuerySupplyHeadroomRequest) Reset()         { *m = QuerySupplyHeadroomRequest{} }
+func (m *QuerySupplyHeadroomRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyHeadroomRequest) ProtoMessage()    {}
+func (*QuerySupplyHeadroomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{12}
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyHeadroomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyHeadroomRequest.Merge(m, src)
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyHeadroomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyHeadroomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyHeadroomRequest proto.InternalMessageInfo
+
+// QuerySupplyHeadroomResponse is response type for the Query/SupplyHeadroom RPC method
+type QuerySupplyHeadroomResponse struct {
+	SupplyHeadroom SupplyHeadroom `protobuf:"bytes,1,opt,name=supply_headroom,json=supplyHeadroom,proto3" json:"supply_headroom" yaml:"supply_headroom"`
+}
+
+func (m *QuerySupplyHeadroomResponse) Reset()         { *m = QuerySupplyHeadroomResponse{} }
+func (m *QuerySupplyHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyHeadroomResponse) ProtoMessage()    {}
+func (*QuerySupplyHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{13}
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyHeadroomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyHeadroomResponse.Merge(m, src)
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyHeadroomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyHeadroomResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyHeadroomResponse) GetSupplyHeadroom() SupplyHeadroom {
+	if m != nil {
+		return m.SupplyHeadroom
+	}
+	return SupplyHeadroom{}
+}
+
+// QueryTotalMintedRequest is request type for the Query/TotalMinted RPC method
+type QueryTotalMintedRequest struct {
+}
+
+func (m *QueryTotalMintedRequest) Reset()         { *m = QueryTotalMintedRequest{} }
+func (m *QueryTotalMintedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalMintedRequest) ProtoMessage()    {}
+func (*QueryTotalMintedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{14}
+}
+func (m *QueryTotalMintedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalMintedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalMintedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalMintedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalMintedRequest.Merge(m, src)
+}
+func (m *QueryTotalMintedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalMintedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalMintedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalMintedRequest proto.InternalMessageInfo
+
+// QueryTotalMintedResponse is response type for the Query/TotalMinted RPC method
+type QueryTotalMintedResponse struct {
+	TotalMinted MintCheckpoint `protobuf:"bytes,1,opt,name=total_minted,json=totalMinted,proto3" json:"total_minted" yaml:"total_minted"`
+}
+
+func (m *QueryTotalMintedResponse) Reset()         { *m = QueryTotalMintedResponse{} }
+func (m *QueryTotalMintedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalMintedResponse) ProtoMessage()    {}
+func (*QueryTotalMintedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{15}
+}
+func (m *QueryTotalMintedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalMintedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalMintedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalMintedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalMintedResponse.Merge(m, src)
+}
+func (m *QueryTotalMintedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalMintedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalMintedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalMintedResponse proto.InternalMessageInfo
+
+func (m *QueryTotalMintedResponse) GetTotalMinted() MintCheckpoint {
+	if m != nil {
+		return m.TotalMinted
+	}
+	return MintCheckpoint{}
+}
+
+// QueryCheckpointsRequest is request type for the Query/Checkpoints RPC method
+type QueryCheckpointsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCheckpointsRequest) Reset()         { *m = QueryCheckpointsRequest{} }
+func (m *QueryCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointsRequest) ProtoMessage()    {}
+func (*QueryCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{16}
+}
+func (m *QueryCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointsRequest.Merge(m, src)
+}
+func (m *QueryCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointsRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCheckpointsResponse is response type for the Query/Checkpoints RPC method
+type QueryCheckpointsResponse struct {
+	Checkpoints []MintCheckpoint    `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCheckpointsResponse) Reset()         { *m = QueryCheckpointsResponse{} }
+func (m *QueryCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointsResponse) ProtoMessage()    {}
+func (*QueryCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{17}
+}
+func (m *QueryCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointsResponse) GetCheckpoints() []MintCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *QueryCheckpointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCheckpointRequest is request type for the Query/Checkpoint RPC method
+type QueryCheckpointRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryCheckpointRequest) Reset()         { *m = QueryCheckpointRequest{} }
+func (m *QueryCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointRequest) ProtoMessage()    {}
+func (*QueryCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{18}
+}
+func (m *QueryCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointRequest.Merge(m, src)
+}
+func (m *QueryCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryCheckpointResponse is response type for the Query/Checkpoint RPC method
+type QueryCheckpointResponse struct {
+	Checkpoint MintCheckpoint `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint"`
+}
+
+func (m *QueryCheckpointResponse) Reset()         { *m = QueryCheckpointResponse{} }
+func (m *QueryCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointResponse) ProtoMessage()    {}
+func (*QueryCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3082aecef156f565, []int{19}
+}
+func (m *QueryCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointResponse.Merge(m, src)
+}
+func (m *QueryCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointResponse) GetCheckpoint() MintCheckpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return MintCheckpoint{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "irishub.mint.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "irishub.mint.QueryParamsResponse")
+	proto.RegisterType((*QueryMinterRequest)(nil), "irishub.mint.QueryMinterRequest")
+	proto.RegisterType((*QueryMinterResponse)(nil), "irishub.mint.QueryMinterResponse")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "irishub.mint.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "irishub.mint.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryInflationScheduleRequest)(nil), "irishub.mint.QueryInflationScheduleRequest")
+	proto.RegisterType((*QueryInflationScheduleResponse)(nil), "irishub.mint.QueryInflationScheduleResponse")
+	proto.RegisterType((*QueryActiveInflationSegmentRequest)(nil), "irishub.mint.QueryActiveInflationSegmentRequest")
+	proto.RegisterType((*QueryActiveInflationSegmentResponse)(nil), "irishub.mint.QueryActiveInflationSegmentResponse")
+	proto.RegisterType((*QueryBlockProvisionRequest)(nil), "irishub.mint.QueryBlockProvisionRequest")
+	proto.RegisterType((*QueryBlockProvisionResponse)(nil), "irishub.mint.QueryBlockProvisionResponse")
+	proto.RegisterType((*QuerySupplyHeadroomRequest)(nil), "irishub.mint.QuerySupplyHeadroomRequest")
+	proto.RegisterType((*QuerySupplyHeadroomResponse)(nil), "irishub.mint.QuerySupplyHeadroomResponse")
+	proto.RegisterType((*QueryTotalMintedRequest)(nil), "irishub.mint.QueryTotalMintedRequest")
+	proto.RegisterType((*QueryTotalMintedResponse)(nil), "irishub.mint.QueryTotalMintedResponse")
+	proto.RegisterType((*QueryCheckpointsRequest)(nil), "irishub.mint.QueryCheckpointsRequest")
+	proto.RegisterType((*QueryCheckpointsResponse)(nil), "irishub.mint.QueryCheckpointsResponse")
+	proto.RegisterType((*QueryCheckpointRequest)(nil), "irishub.mint.QueryCheckpointRequest")
+	proto.RegisterType((*QueryCheckpointResponse)(nil), "irishub.mint.QueryCheckpointResponse")
+}
+
+func init() { proto.RegisterFile("mint/query.proto", fileDescriptor_3082aecef156f565) }
+
+var fileDescriptor_3082aecef156f565 = []byte{
+	// 1026 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x97, 0x4d, 0x6f, 0xe3, 0x54,
+	0x17, 0xc7, 0xeb, 0xe9, 0xf3, 0x44, 0xe8, 0x64, 0x68, 0x3b, 0xb7, 0x25, 0xa4, 0x6e, 0xea, 0xa4,
+	0x97, 0x76, 0xa6, 0x1d, 0x86, 0x78, 0x5a, 0x56, 0xcc, 0x0a, 0x32, 0x23, 0xc1, 0x2c, 0x90, 0x8a,
+	0x67, 0x56, 0x08, 0x14, 0x39, 0xe9, 0xc5, 0x31, 0x89, 0x7d, 0x3d, 0xb1, 0x53, 0xa8, 0x10, 0xe2,
+	0x45, 0xb0, 0x9b, 0x05, 0x08, 0x16, 0x2c, 0xf8, 0x0c, 0x7c, 0x8e, 0x59, 0x8e, 0xc4, 0x86, 0xd5,
+	0x08, 0xb5, 0xac, 0x59, 0xf0, 0x09, 0x90, 0xef, 0x3d, 0x8e, 0x7d, 0x6d, 0xe7, 0x85, 0x4d, 0x95,
+	0x9e, 0xf3, 0x3f, 0xe7, 0xfc, 0xee, 0xb9, 0xb9, 0xe7, 0x28, 0xb0, 0xe1, 0xb9, 0x7e, 0x64, 0x3e,
+	0x99, 0xb0, 0xf1, 0x45, 0x3b, 0x18, 0xf3, 0x88, 0x93, 0xeb, 0xee, 0xd8, 0x0d, 0x07, 0x93, 0x5e,
+	0x3b, 0xf6, 0xe8, 0xbb, 0x7d, 0x1e, 0x7a, 0x3c, 0x94, 0x0a, 0x33, 0xb0, 0x1d, 0xd7, 0xb7, 0x23,
+	0x97, 0xfb, 0x52, 0xac, 0xaf, 0x8b, 0xf0, 0xf8, 0x0f, 0x1a, 0x0c, 0xd4, 0xf7, 0xec, 0x90, 0x99,
+	0xe7, 0xc7, 0x3d, 0x16, 0xd9, 0xc7, 0x66, 0x9f, 0xbb, 0x49, 0xc0, 0x96, 0xc3, 0x1d, 0x2e, 0x3e,
+	0x9a, 0xf1, 0x27, 0xb4, 0x36, 0x1c, 0xce, 0x9d, 0x11, 0x33, 0xed, 0xc0, 0x35, 0x6d, 0xdf, 0xe7,
+	0x91, 0xa8, 0x11, 0x4a, 0x2f, 0xdd, 0x02, 0xf2, 0x41, 0x5c, 0xfe, 0xd4, 0x1e, 0xdb, 0x5e, 0x68,
+	0xb1, 0x27, 0x13, 0x16, 0x46, 0xf4, 0x33, 0xd8, 0x54, 0xac, 0x61, 0xc0, 0xfd, 0x90, 0x91, 0x13,
+	0xa8, 0x04, 0xc2, 0x52, 0xd7, 0x5a, 0xda, 0x61, 0xf5, 0x64, 0xab, 0x9d, 0x3d, 0x4f, 0x5b, 0xaa,
+	0x3b, 0xff, 0x7b, 0xf6, 0xa2, 0xb9, 0x62, 0xa1, 0x92, 0xdc, 0x81, 0xd5, 0x31, 0x0b, 0xeb, 0xd7,
+	0x44, 0x80, 0xde, 0x96, 0x47, 0x68, 0xcb, 0xa6, 0x9c, 0xda, 0x0e, 0x4b, 0x92, 0x5b, 0xb1, 0x6c,
+	0x8a, 0xf3, 0xbe, 0xeb, 0x47, 0x6c, 0x9c, 0xe0, 0x3c, 0x84, 0x4d, 0xc5, 0x9a, 0xe2, 0x78, 0xc2,
+	0x52, 0x8e, 0x23, 0xd5, 0x09, 0x8e, 0x54, 0x52, 0x03, 0x1a, 0x22, 0xd5, 0x3b, 0xbe, 0x3f, 0xb1,
+	0x47, 0xa7, 0x63, 0x7e, 0xee, 0x86, 0x71, 0x3b, 0x92, 0x52, 0x4f, 0x35, 0xd8, 0x9d, 0x21, 0xc0,
+	0xaa, 0x43, 0xb8, 0x61, 0x0b, 0x5f, 0x37, 0x98, 0x3a, 0x11, 0xa0, 0x91, 0x1c, 0x2f, 0xbe, 0xa1,
+	0x36, 0xde, 0x50, 0xfb, 0x01, 0xeb, 0xdf, 0xe7, 0xae, 0xdf, 0x69, 0xc5, 0x20, 0xff, 0xbc, 0x68,
+	0xd6, 0x2f, 0x6c, 0x6f, 0x74, 0x8f, 0x16, 0x92, 0x50, 0x6b, 0xc3, 0xce, 0x15, 0xa5, 0x4d, 0xa4,
+	0x79, 0xe8, 0x7f, 0x32, 0x12, 0xf7, 0xf6, 0xa8, 0x3f, 0x60, 0x67, 0x93, 0x11, 0x4b, 0x78, 0x7b,
+	0x60, 0xcc, 0x12, 0x20, 0xef, 0xdb, 0xf0, 0x52, 0x88, 0xb6, 0xba, 0xd6, 0x5a, 0x3d, 0xac, 0x9e,
+	0x18, 0x6a, 0x9f, 0xd2, 0x50, 0xe6, 0x78, 0xcc, 0x8f, 0xb0, 0x63, 0xd3, 0x28, 0xba, 0x0f, 0x54,
+	0xb6, 0xa4, 0x1f, 0xb9, 0xe7, 0x2c, 0x2f, 0x4f, 0x48, 0x7e, 0xd4, 0xe0, 0xb5, 0xb9, 0x32, 0xe4,
+	0xf9, 0x14, 0xd6, 0x6c, 0xa1, 0xe8, 0x86, 0xd2, 0x83, 0xcd, 0xdb, 0x57, 0xa9, 0xca, 0xb3, 0x74,
+	0x76, 0xb1, 0x89, 0xaf, 0x60, 0x13, 0x95, 0x4c, 0xd4, 0x7a, 0x59, 0x1a, 0x50, 0x4d, 0x1b, 0xa0,
+	0x0b, 0xa4, 0xce, 0x88, 0xf7, 0x87, 0xd3, 0xb6, 0x26, 0xc4, 0xdf, 0x68, 0xb0, 0x53, 0xea, 0x46,
+	0xd2, 0x1e, 0xac, 0xf7, 0x62, 0x4f, 0x7a, 0x47, 0x88, 0xba, 0x5d, 0x7a, 0xcf, 0xe2, 0x92, 0x0d,
+	0xe4, 0xab, 0x49, 0xbe, 0x5c, 0x3c, 0xb5, 0xd6, 0x7a, 0x4a, 0xad, 0x29, 0xe1, 0xa3, 0x49, 0x10,
+	0x8c, 0x2e, 0xde, 0x63, 0xf6, 0xd9, 0x98, 0x73, 0x2f, 0x21, 0xfc, 0x2e, 0x21, 0xcc, 0xbb, 0x91,
+	0x90, 0xc1, 0x7a, 0x28, 0x3c, 0xdd, 0x01, 0xba, 0xa6, 0xdf, 0x44, 0xa5, 0x99, 0x6a, 0x78, 0x1e,
+	0x32, 0x97, 0x82, 0x5a, 0x6b, 0xa1, 0xa2, 0xa7, 0xdb, 0xf0, 0xaa, 0xa0, 0x78, 0xcc, 0x23, 0x7b,
+	0x24, 0x9e, 0xd5, 0x59, 0x42, 0xf8, 0x39, 0xd4, 0x8b, 0x2e, 0xa4, 0xfb, 0x08, 0xae, 0x47, 0xb1,
+	0xb9, 0x2b, 0xde, 0xde, 0x59, 0x39, 0x5a, 0x1c, 0x73, 0x7f, 0xc0, 0xfa, 0xc3, 0x80, 0xbb, 0x7e,
+	0xd4, 0xd9, 0x41, 0xb4, 0x4d, 0x89, 0x96, 0x8d, 0xa7, 0x56, 0x35, 0x4a, 0xab, 0xd0, 0xc7, 0x08,
+	0x95, 0x06, 0x27, 0x8f, 0x98, 0xbc, 0x05, 0x90, 0x4e, 0xd3, 0xfc, 0x9d, 0x65, 0x47, 0x8f, 0x90,
+	0x5b, 0x19, 0x31, 0xfd, 0x55, 0x83, 0x7a, 0x31, 0x2d, 0x1e, 0xe8, 0x01, 0x54, 0xfb, 0xa9, 0x19,
+	0x5f, 0xd3, 0xfc, 0xf3, 0xc8, 0xb7, 0x94, 0x0d, 0x23, 0xf7, 0x14, 0xba, 0xc5, 0x83, 0x31, 0x8b,
+	0x77, 0x17, 0x6a, 0x39, 0xba, 0xe4, 0xcc, 0x35, 0xa8, 0x0c, 0x98, 0xeb, 0x0c, 0xe4, 0x73, 0x5a,
+	0xb5, 0xf0, 0x3f, 0xfa, 0x71, 0xa1, 0x4d, 0xd3, 0xe3, 0x74, 0x00, 0x52, 0xae, 0xa5, 0x6e, 0x47,
+	0x9e, 0x26, 0x13, 0x75, 0xf2, 0x37, 0xc0, 0xff, 0x45, 0x7e, 0x32, 0x84, 0x8a, 0x5c, 0x00, 0xa4,
+	0xa5, 0xe6, 0x28, 0xee, 0x17, 0x7d, 0x6f, 0x8e, 0x42, 0xc2, 0xd1, 0xc6, 0xb7, 0xbf, 0xff, 0xf5,
+	0xd3, 0xb5, 0x1a, 0xd9, 0x32, 0x51, 0x2a, 0x36, 0xa1, 0x89, 0x5b, 0x65, 0x08, 0x15, 0x39, 0xde,
+	0x4b, 0x8b, 0x29, 0xdb, 0x43, 0xdf, 0x9b, 0xa3, 0x98, 0x5f, 0xcc, 0x93, 0x25, 0x7e, 0xd6, 0x60,
+	0x23, 0xbf, 0x0e, 0xc8, 0xed, 0x92, 0xac, 0x33, 0x96, 0x8a, 0xfe, 0xfa, 0x52, 0x5a, 0x64, 0xb9,
+	0x25, 0x58, 0xf6, 0x48, 0x53, 0x65, 0x29, 0xac, 0x0b, 0xf2, 0x8b, 0x06, 0x37, 0x0a, 0x63, 0x9f,
+	0x94, 0xd5, 0x9a, 0xb5, 0x3d, 0xf4, 0x3b, 0xcb, 0x89, 0x91, 0xec, 0x50, 0x90, 0x51, 0xd2, 0x52,
+	0xc9, 0xdc, 0x24, 0xa0, 0x9b, 0x6c, 0x0c, 0xf2, 0x9b, 0x06, 0xb5, 0xf2, 0x01, 0x4e, 0xee, 0x96,
+	0xf5, 0x62, 0xde, 0x62, 0xd1, 0x8f, 0xff, 0x43, 0x04, 0x92, 0x9a, 0x82, 0xf4, 0x88, 0xdc, 0x5a,
+	0x44, 0x6a, 0xca, 0x7d, 0x41, 0x9e, 0x6a, 0xb0, 0xa6, 0x6e, 0x01, 0x72, 0x58, 0x52, 0xb6, 0x74,
+	0x8f, 0xe8, 0x47, 0x4b, 0x28, 0x11, 0xec, 0x40, 0x80, 0x35, 0xc9, 0xae, 0x0a, 0x96, 0x5b, 0x13,
+	0x02, 0x47, 0x9d, 0xd9, 0xa5, 0x38, 0xa5, 0x4b, 0x43, 0x3f, 0x5a, 0x42, 0x39, 0x1f, 0x27, 0xb7,
+	0x10, 0xc8, 0xd7, 0x1a, 0x54, 0x33, 0x03, 0x9e, 0x1c, 0x94, 0x54, 0x28, 0xee, 0x06, 0xfd, 0xe6,
+	0x22, 0x19, 0x52, 0x50, 0x41, 0xd1, 0x20, 0xba, 0x4a, 0x91, 0x9d, 0xfd, 0xe4, 0x2b, 0xa8, 0x66,
+	0x26, 0x72, 0x29, 0x41, 0x71, 0x11, 0xe8, 0x37, 0x17, 0xc9, 0x90, 0x60, 0x4f, 0x10, 0xec, 0x90,
+	0x6d, 0x95, 0x20, 0x3b, 0xb5, 0xbf, 0xd7, 0x00, 0xd2, 0x50, 0xb2, 0x3f, 0x37, 0x73, 0x52, 0xff,
+	0x60, 0x81, 0x0a, 0xcb, 0xdf, 0x16, 0xe5, 0xf7, 0x09, 0x9d, 0x59, 0xde, 0xfc, 0x42, 0x8e, 0xf3,
+	0x2f, 0x3b, 0xef, 0x3e, 0xbb, 0x34, 0xb4, 0xe7, 0x97, 0x86, 0xf6, 0xe7, 0xa5, 0xa1, 0xfd, 0x70,
+	0x65, 0xac, 0x3c, 0xbf, 0x32, 0x56, 0xfe, 0xb8, 0x32, 0x56, 0x3e, 0x7c, 0xc3, 0x71, 0xa3, 0xb8,
+	0x54, 0x9f, 0x7b, 0x22, 0x8f, 0xcf, 0xa2, 0x34, 0x1f, 0x8f, 0xbf, 0xed, 0x21, 0x36, 0xf6, 0x22,
+	0x60, 0x61, 0xaf, 0x22, 0x7e, 0x00, 0xbc, 0xf9, 0xef, 0x00, 0x5b, 0xda, 0xe0, 0x45, 0xa6, 0x0c,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the mint parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Minter queries the minter state
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// AnnualProvisions queries the current annual provisions
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// InflationSchedule queries the inflation schedule
+	InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error)
+	// ActiveInflationSegment queries the segment of the inflation schedule in effect
+	ActiveInflationSegment(ctx context.Context, in *QueryActiveInflationSegmentRequest, opts ...grpc.CallOption) (*QueryActiveInflationSegmentResponse, error)
+	// BlockProvision queries the provision of the next block
+	BlockProvision(ctx context.Context, in *QueryBlockProvisionRequest, opts ...grpc.CallOption) (*QueryBlockProvisionResponse, error)
+	// SupplyHeadroom queries the amount which can still be minted before the max supply is reached
+	SupplyHeadroom(ctx context.Context, in *QuerySupplyHeadroomRequest, opts ...grpc.CallOption) (*QuerySupplyHeadroomResponse, error)
+	// TotalMinted queries the total amount of coins minted so far
+	TotalMinted(ctx context.Context, in *QueryTotalMintedRequest, opts ...grpc.CallOption) (*QueryTotalMintedResponse, error)
+	// Checkpoints queries all checkpoints of the total minted coins
+	Checkpoints(ctx context.Context, in *QueryCheckpointsRequest, opts ...grpc.CallOption) (*QueryCheckpointsResponse, error)
+	// Checkpoint queries the last checkpoint of the total minted coins at or before a height
+	Checkpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/Minter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationSchedule(ctx context.Context, in *QueryInflationScheduleRequest, opts ...grpc.CallOption) (*QueryInflationScheduleResponse, error) {
+	out := new(QueryInflationScheduleResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/InflationSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveInflationSegment(ctx context.Context, in *QueryActiveInflationSegmentRequest, opts ...grpc.CallOption) (*QueryActiveInflationSegmentResponse, error) {
+	out := new(QueryActiveInflationSegmentResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/ActiveInflationSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockProvision(ctx context.Context, in *QueryBlockProvisionRequest, opts ...grpc.CallOption) (*QueryBlockProvisionResponse, error) {
+	out := new(QueryBlockProvisionResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/BlockProvision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyHeadroom(ctx context.Context, in *QuerySupplyHeadroomRequest, opts ...grpc.CallOption) (*QuerySupplyHeadroomResponse, error) {
+	out := new(QuerySupplyHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/SupplyHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalMinted(ctx context.Context, in *QueryTotalMintedRequest, opts ...grpc.CallOption) (*QueryTotalMintedResponse, error) {
+	out := new(QueryTotalMintedResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/TotalMinted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Checkpoints(ctx context.Context, in *QueryCheckpointsRequest, opts ...grpc.CallOption) (*QueryCheckpointsResponse, error) {
+	out := new(QueryCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/Checkpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Checkpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error) {
+	out := new(QueryCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/irishub.mint.Query/Checkpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the mint parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Minter queries the minter state
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// AnnualProvisions queries the current annual provisions
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// InflationSchedule queries the inflation schedule
+	InflationSchedule(context.Context, *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error)
+	// ActiveInflationSegment queries the segment of the inflation schedule in effect
+	ActiveInflationSegment(context.Context, *QueryActiveInflationSegmentRequest) (*QueryActiveInflationSegmentResponse, error)
+	// BlockProvision queries the provision of the next block
+	BlockProvision(context.Context, *QueryBlockProvisionRequest) (*QueryBlockProvisionResponse, error)
+	// SupplyHeadroom queries the amount which can still be minted before the max supply is reached
+	SupplyHeadroom(context.Context, *QuerySupplyHeadroomRequest) (*QuerySupplyHeadroomResponse, error)
+	// TotalMinted queries the total amount of coins minted so far
+	TotalMinted(context.Context, *QueryTotalMintedRequest) (*QueryTotalMintedResponse, error)
+	// Checkpoints queries all checkpoints of the total minted coins
+	Checkpoints(context.Context, *QueryCheckpointsRequest) (*QueryCheckpointsResponse, error)
+	// Checkpoint queries the last checkpoint of the total minted coins at or before a height
+	Checkpoint(context.Context, *QueryCheckpointRequest) (*QueryCheckpointResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Minter(ctx context.Context, req *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) InflationSchedule(ctx context.Context, req *QueryInflationScheduleRequest) (*QueryInflationScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationSchedule not implemented")
+}
+func (*UnimplementedQueryServer) ActiveInflationSegment(ctx context.Context, req *QueryActiveInflationSegmentRequest) (*QueryActiveInflationSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveInflationSegment not implemented")
+}
+func (*UnimplementedQueryServer) BlockProvision(ctx context.Context, req *QueryBlockProvisionRequest) (*QueryBlockProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProvision not implemented")
+}
+func (*UnimplementedQueryServer) SupplyHeadroom(ctx context.Context, req *QuerySupplyHeadroomRequest) (*QuerySupplyHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyHeadroom not implemented")
+}
+func (*UnimplementedQueryServer) TotalMinted(ctx context.Context, req *QueryTotalMintedRequest) (*QueryTotalMintedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalMinted not implemented")
+}
+func (*UnimplementedQueryServer) Checkpoints(ctx context.Context, req *QueryCheckpointsRequest) (*QueryCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoints not implemented")
+}
+func (*UnimplementedQueryServer) Checkpoint(ctx context.Context, req *QueryCheckpointRequest) (*QueryCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/Minter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/InflationSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationSchedule(ctx, req.(*QueryInflationScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveInflationSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveInflationSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveInflationSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/ActiveInflationSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveInflationSegment(ctx, req.(*QueryActiveInflationSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/BlockProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockProvision(ctx, req.(*QueryBlockProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/SupplyHeadroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyHeadroom(ctx, req.(*QuerySupplyHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalMintedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalMinted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/TotalMinted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalMinted(ctx, req.(*QueryTotalMintedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Checkpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Checkpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/Checkpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Checkpoints(ctx, req.(*QueryCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/irishub.mint.Query/Checkpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Checkpoint(ctx, req.(*QueryCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "irishub.mint.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "InflationSchedule",
+			Handler:    _Query_InflationSchedule_Handler,
+		},
+		{
+			MethodName: "ActiveInflationSegment",
+			Handler:    _Query_ActiveInflationSegment_Handler,
+		},
+		{
+			MethodName: "BlockProvision",
+			Handler:    _Query_BlockProvision_Handler,
+		},
+		{
+			MethodName: "SupplyHeadroom",
+			Handler:    _Query_SupplyHeadroom_Handler,
+		},
+		{
+			MethodName: "TotalMinted",
+			Handler:    _Query_TotalMinted_Handler,
+		},
+		{
+			MethodName: "Checkpoints",
+			Handler:    _Query_Checkpoints_Handler,
+		},
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Query_Checkpoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mint/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Res != nil {
+		{
+			size, err := m.Res.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AnnualProvisions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveInflationSegmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveInflationSegmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveInflationSegmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveInflationSegmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveInflationSegmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveInflationSegmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ActiveSegment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BlockProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyHeadroomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyHeadroomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyHeadroomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SupplyHeadroom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalMintedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalMintedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalMintedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalMintedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	if m.Res != nil {
-		{
-			size, err := m.Res.MarshalToSizedBuffer(dAtA[:i])
+		l = m.Res.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryActiveInflationSegmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveInflationSegmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActiveSegment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyHeadroomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupplyHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SupplyHeadroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalMintedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalMintedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Res == nil {
+				m.Res = &query.PageResponse{}
+			}
+			if err := m.Res.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, InflationSegment{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveInflationSegmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveInflationSegmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveInflationSegmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveInflationSegmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveInflationSegmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveInflationSegmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSegment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActiveSegment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyHeadroomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyHeadroomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyHeadroom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyHeadroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalMintedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalMintedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalMintedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalMintedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Res != nil {
-		l = m.Res.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -365,12 +3423,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -395,7 +3489,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -418,15 +3512,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -453,13 +3547,14 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Checkpoints = append(m.Checkpoints, MintCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Res", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -486,10 +3581,168 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Res == nil {
-				m.Res = &query.PageResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Res.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// systemModules are the module accounts whose balances are tracked by their modules, which
+// must not receive minted coins as a module recipient; the fee collector and the community
+// pool have recipient types of their own
+var systemModules = map[string]bool{
+	ModuleName:                     true,
+	authtypes.FeeCollectorName:     true,
+	distrtypes.ModuleName:          true,
+	stakingtypes.BondedPoolName:    true,
+	stakingtypes.NotBondedPoolName: true,
+	govtypes.ModuleName:            true,
+}
+
+// NewMintRecipient creates a recipient of the given share of the minted coins
+func NewMintRecipient(recipientType RecipientType, address string, weight sdk.Dec) MintRecipient {
+	return MintRecipient{
+		Type:    recipientType,
+		Address: address,
+		Weight:  weight,
+	}
+}
+
+// DefaultMintRecipients returns the recipients in effect if none is defined, which send all
+// minted coins to the fee collector
+func DefaultMintRecipients() []MintRecipient {
+	return []MintRecipient{NewMintRecipient(RecipientFeeCollector, "", sdk.OneDec())}
+}
+
+// Validate returns err if the recipient is invalid
+func (r MintRecipient) Validate() error {
+	switch r.Type {
+	case RecipientFeeCollector, RecipientCommunityPool:
+		if len(r.Address) != 0 {
+			return fmt.Errorf("recipient of type %s must not define an address", r.Type)
+		}
+	case RecipientModule:
+		if len(strings.TrimSpace(r.Address)) == 0 {
+			return fmt.Errorf("module recipient must define a module name")
+		}
+		if systemModules[r.Address] {
+			return fmt.Errorf("module recipient must not be the %s module account", r.Address)
+		}
+	case RecipientAccount:
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid account recipient address (%s): %w", r.Address, err)
+		}
+	default:
+		return fmt.Errorf("invalid recipient type: %d", r.Type)
+	}
+
+	if r.Weight.IsNil() || !r.Weight.IsPositive() || r.Weight.GT(sdk.OneDec()) {
+		return fmt.Errorf("recipient weight (%s) should be between (0, 1]", r.Weight)
+	}
+	return nil
+}
+
+// ValidateMintRecipients returns err if any recipient is invalid or duplicated, or the
+// weights of a non-empty list don't add up to 1
+func ValidateMintRecipients(recipients []MintRecipient) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool, len(recipients))
+	for i, recipient := range recipients {
+		if err := recipient.Validate(); err != nil {
+			return fmt.Errorf("invalid mint recipient %d: %w", i, err)
+		}
+
+		key := fmt.Sprintf("%d/%s", recipient.Type, recipient.Address)
+		if seen[key] {
+			return fmt.Errorf("duplicate mint recipient %d: %s %s", i, recipient.Type, recipient.Address)
+		}
+		seen[key] = true
+
+		total = total.Add(recipient.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("weights of the mint recipients must add up to 1, got %s", total)
+	}
+	return nil
+}
+
+// MintRecipients returns the recipients of the minted coins, which default to the fee collector
+func (p Params) MintRecipients() []MintRecipient {
+	if len(p.Recipients) == 0 {
+		return DefaultMintRecipients()
+	}
+	return p.Recipients
+}
+
+// SplitMintedCoin returns the share of the given coin for each recipient, in order. The
+// shares are truncated and the remainder is added to the share of the last recipient so
+// that the shares add up to the coin
+func SplitMintedCoin(recipients []MintRecipient, coin sdk.Coin) []sdk.Coin {
+	shares := make([]sdk.Coin, len(recipients))
+	remaining := coin.Amount
+	for i, recipient := range recipients {
+		amount := remaining
+		if i < len(recipients)-1 {
+			amount = recipient.Weight.MulInt(coin.Amount).TruncateInt()
+		}
+		shares[i] = sdk.NewCoin(coin.Denom, amount)
+		remaining = remaining.Sub(amount)
+	}
+	return shares
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidateMintRecipients(t *testing.T) {
+	account := sdk.AccAddress([]byte("addr1_______________")).String()
+	half := sdk.NewDecWithPrec(5, 1)
+	quarter := sdk.NewDecWithPrec(25, 2)
+
+	tests := []struct {
+		name       string
+		expectPass bool
+		recipients []MintRecipient
+	}{
+		{"empty", true, nil},
+		{"default", true, DefaultMintRecipients()},
+		{"all types", true, []MintRecipient{
+			NewMintRecipient(RecipientFeeCollector, "", half),
+			NewMintRecipient(RecipientCommunityPool, "", sdk.NewDecWithPrec(2, 1)),
+			NewMintRecipient(RecipientModule, "ecosystem", sdk.NewDecWithPrec(2, 1)),
+			NewMintRecipient(RecipientAccount, account, sdk.NewDecWithPrec(1, 1)),
+		}},
+		{"weights below one", false, []MintRecipient{
+			NewMintRecipient(RecipientFeeCollector, "", half),
+			NewMintRecipient(RecipientCommunityPool, "", quarter),
+		}},
+		{"weights above one", false, []MintRecipient{
+			NewMintRecipient(RecipientFeeCollector, "", half),
+			NewMintRecipient(RecipientCommunityPool, "", sdk.NewDecWithPrec(6, 1)),
+		}},
+		{"zero weight", false, []MintRecipient{
+			NewMintRecipient(RecipientFeeCollector, "", sdk.OneDec()),
+			NewMintRecipient(RecipientCommunityPool, "", sdk.ZeroDec()),
+		}},
+		{"negative weight", false, []MintRecipient{
+			NewMintRecipient(RecipientFeeCollector, "", sdk.NewDecWithPrec(15, 1)),
+			NewMintRecipient(RecipientCommunityPool, "", half.Neg()),
+		}},
+		{"duplicate recipient", false, []MintRecipient{
+			NewMintRecipient(RecipientModule, "ecosystem", half),
+			NewMintRecipient(RecipientModule, "ecosystem", half),
+		}},
+		{"fee collector with address", false, []MintRecipient{NewMintRecipient(RecipientFeeCollector, account, sdk.OneDec())}},
+		{"module without name", false, []MintRecipient{NewMintRecipient(RecipientModule, " ", sdk.OneDec())}},
+		{"mint module", false, []MintRecipient{NewMintRecipient(RecipientModule, ModuleName, sdk.OneDec())}},
+		{"fee collector module", false, []MintRecipient{NewMintRecipient(RecipientModule, authtypes.FeeCollectorName, sdk.OneDec())}},
+		{"distribution module", false, []MintRecipient{NewMintRecipient(RecipientModule, distrtypes.ModuleName, sdk.OneDec())}},
+		{"bonded pool", false, []MintRecipient{NewMintRecipient(RecipientModule, stakingtypes.BondedPoolName, sdk.OneDec())}},
+		{"not bonded pool", false, []MintRecipient{NewMintRecipient(RecipientModule, stakingtypes.NotBondedPoolName, sdk.OneDec())}},
+		{"gov module", false, []MintRecipient{NewMintRecipient(RecipientModule, govtypes.ModuleName, sdk.OneDec())}},
+		{"invalid account", false, []MintRecipient{NewMintRecipient(RecipientAccount, "invalid", sdk.OneDec())}},
+		{"invalid type", false, []MintRecipient{NewMintRecipient(RecipientType(4), "", sdk.OneDec())}},
+	}
+	for _, tc := range tests {
+		err := ValidateMintRecipients(tc.recipients)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSplitMintedCoin(t *testing.T) {
+	recipients := []MintRecipient{
+		NewMintRecipient(RecipientFeeCollector, "", sdk.NewDecWithPrec(6, 1)),
+		NewMintRecipient(RecipientCommunityPool, "", sdk.NewDecWithPrec(3, 1)),
+		NewMintRecipient(RecipientModule, "ecosystem", sdk.NewDecWithPrec(1, 1)),
+	}
+
+	tests := []struct {
+		amount   int64
+		expected []int64
+	}{
+		{1000, []int64{600, 300, 100}},
+		{1009, []int64{605, 302, 102}},
+		{1, []int64{0, 0, 1}},
+		{0, []int64{0, 0, 0}},
+	}
+	for _, tc := range tests {
+		shares := SplitMintedCoin(recipients, sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.amount))
+		require.Len(t, shares, len(recipients))
+		for i, share := range shares {
+			require.Equal(t, sdk.DefaultBondDenom, share.Denom)
+			require.True(t, sdk.NewInt(tc.expected[i]).Equal(share.Amount), "amount %d, recipient %d: got %s", tc.amount, i, share.Amount)
+		}
+	}
+
+	params := DefaultParams()
+	params.Recipients = nil
+	require.Equal(t, DefaultMintRecipients(), params.MintRecipients())
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHeightInflationSegment creates a segment with the given inflation rate which starts at the given height
+func NewHeightInflationSegment(startHeight int64, inflation sdk.Dec) InflationSegment {
+	return InflationSegment{
+		StartHeight: startHeight,
+		Inflation:   inflation,
+	}
+}
+
+// NewTimeInflationSegment creates a segment with the given inflation rate which starts at the given time
+func NewTimeInflationSegment(startTime time.Time, inflation sdk.Dec) InflationSegment {
+	return InflationSegment{
+		StartTime: &startTime,
+		Inflation: inflation,
+	}
+}
+
+// NewHalvingInflationSegment creates a halving segment which starts at either the given height or time
+func NewHalvingInflationSegment(startHeight int64, startTime *time.Time) InflationSegment {
+	return InflationSegment{
+		StartHeight: startHeight,
+		StartTime:   startTime,
+		Inflation:   sdk.ZeroDec(),
+		Halving:     true,
+	}
+}
+
+// IsStarted returns true if the boundary of the segment has been reached at the given height and time
+func (s InflationSegment) IsStarted(height int64, blockTime time.Time) bool {
+	if s.StartTime != nil {
+		return !blockTime.Before(*s.StartTime)
+	}
+	return height >= s.StartHeight
+}
+
+// Validate returns err if the segment is invalid
+func (s InflationSegment) Validate() error {
+	if s.StartTime != nil && s.StartHeight != 0 {
+		return fmt.Errorf("segment must start at either a height or a time")
+	}
+	if s.StartTime == nil && s.StartHeight <= 0 {
+		return fmt.Errorf("segment start height (%d) must be positive", s.StartHeight)
+	}
+	if s.Halving {
+		if !s.Inflation.IsNil() && !s.Inflation.IsZero() {
+			return fmt.Errorf("halving segment must not define an inflation rate")
+		}
+		return nil
+	}
+	if s.Inflation.IsNil() {
+		return fmt.Errorf("segment inflation rate must be defined")
+	}
+	return validateInflation(s.Inflation)
+}
+
+// ValidateInflationSchedule returns err if any segment is invalid, the segments mix height
+// and time boundaries, or the boundaries are not strictly ascending. Heights and times can
+// not be ordered against each other, so a mixed schedule could let a segment shadow another
+func ValidateInflationSchedule(schedule []InflationSegment) error {
+	var lastHeight int64
+	var lastTime *time.Time
+
+	for i, segment := range schedule {
+		if err := segment.Validate(); err != nil {
+			return fmt.Errorf("invalid inflation segment %d: %w", i, err)
+		}
+		if (segment.StartTime != nil) != (schedule[0].StartTime != nil) {
+			return fmt.Errorf("inflation segment %d must start at the same kind of boundary as the first segment", i)
+		}
+
+		if segment.StartTime != nil {
+			if lastTime != nil && !segment.StartTime.After(*lastTime) {
+				return fmt.Errorf("inflation segment %d starts at %s, not after the previous time boundary %s", i, segment.StartTime, lastTime)
+			}
+			lastTime = segment.StartTime
+			continue
+		}
+
+		if segment.StartHeight <= lastHeight {
+			return fmt.Errorf("inflation segment %d starts at height %d, not after the previous height boundary %d", i, segment.StartHeight, lastHeight)
+		}
+		lastHeight = segment.StartHeight
+	}
+	return nil
+}
+
+// ActiveInflationSegment returns the last segment of the schedule whose boundary has been
+// reached at the given height and time, along with the inflation rate in effect. The
+// Inflation param is in effect until the first segment starts
+func (p Params) ActiveInflationSegment(height int64, blockTime time.Time) ActiveInflationSegment {
+	active := ActiveInflationSegment{
+		Index:     -1,
+		Inflation: p.Inflation,
+	}
+
+	inflation := p.Inflation
+	for i, segment := range p.InflationSchedule {
+		if !segment.IsStarted(height, blockTime) {
+			continue
+		}
+
+		if segment.Halving {
+			inflation = inflation.QuoInt64(2)
+		} else {
+			inflation = segment.Inflation
+		}
+
+		segment := segment
+		active = ActiveInflationSegment{
+			Index:     int64(i),
+			Segment:   &segment,
+			Inflation: inflation,
+		}
+	}
+	return active
+}
+
+// WithScheduledInflation returns the params with the inflation rate in effect at the given height and time
+func (p Params) WithScheduledInflation(height int64, blockTime time.Time) Params {
+	p.Inflation = p.ActiveInflationSegment(height, blockTime).Inflation
+	return p
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateInflationSchedule(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+	mixed := NewHalvingInflationSegment(10, &startTime)
+
+	tests := []struct {
+		name       string
+		expectPass bool
+		schedule   []InflationSegment
+	}{
+		{"empty schedule", true, nil},
+		{"height segments", true, []InflationSegment{
+			NewHeightInflationSegment(10, sdk.NewDecWithPrec(8, 2)),
+			NewHalvingInflationSegment(20, nil),
+		}},
+		{"time segments", true, []InflationSegment{
+			NewTimeInflationSegment(startTime, sdk.NewDecWithPrec(8, 2)),
+			NewHalvingInflationSegment(0, &endTime),
+		}},
+		{"interleaved segments", false, []InflationSegment{
+			NewHeightInflationSegment(10, sdk.NewDecWithPrec(8, 2)),
+			NewTimeInflationSegment(startTime, sdk.NewDecWithPrec(6, 2)),
+			NewHalvingInflationSegment(20, nil),
+		}},
+		{"height segment after time segment", false, []InflationSegment{
+			NewTimeInflationSegment(startTime, sdk.NewDecWithPrec(6, 2)),
+			NewHeightInflationSegment(10, sdk.NewDecWithPrec(8, 2)),
+		}},
+		{"zero start height", false, []InflationSegment{NewHeightInflationSegment(0, sdk.NewDecWithPrec(8, 2))}},
+		{"height and time boundary", false, []InflationSegment{mixed}},
+		{"inflation too large", false, []InflationSegment{NewHeightInflationSegment(10, sdk.NewDecWithPrec(3, 1))}},
+		{"missing inflation", false, []InflationSegment{{StartHeight: 10}}},
+		{"halving with inflation", false, []InflationSegment{{StartHeight: 10, Inflation: sdk.NewDecWithPrec(1, 2), Halving: true}}},
+		{"descending heights", false, []InflationSegment{
+			NewHeightInflationSegment(20, sdk.NewDecWithPrec(8, 2)),
+			NewHeightInflationSegment(10, sdk.NewDecWithPrec(6, 2)),
+		}},
+		{"duplicate times", false, []InflationSegment{
+			NewTimeInflationSegment(startTime, sdk.NewDecWithPrec(8, 2)),
+			NewTimeInflationSegment(startTime, sdk.NewDecWithPrec(6, 2)),
+		}},
+	}
+	for _, tc := range tests {
+		err := ValidateInflationSchedule(tc.schedule)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestActiveInflationSegment(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	heightParams := DefaultParams()
+	heightParams.InflationSchedule = []InflationSegment{
+		NewHeightInflationSegment(100, sdk.NewDecWithPrec(8, 2)),
+		NewHalvingInflationSegment(200, nil),
+		NewHalvingInflationSegment(300, nil),
+	}
+	require.NoError(t, heightParams.Validate())
+	timeParams := DefaultParams()
+	timeParams.InflationSchedule = []InflationSegment{
+		NewHalvingInflationSegment(0, &startTime),
+		NewTimeInflationSegment(startTime.Add(time.Hour), sdk.NewDecWithPrec(1, 2)),
+	}
+	require.NoError(t, timeParams.Validate())
+
+	// an interleaved schedule is rejected, as its height boundaries could shadow the time boundaries
+	interleavedParams := DefaultParams()
+	interleavedParams.InflationSchedule = []InflationSegment{
+		NewHeightInflationSegment(100, sdk.NewDecWithPrec(8, 2)),
+		NewHalvingInflationSegment(0, &startTime),
+		NewHalvingInflationSegment(200, nil),
+	}
+	require.Error(t, interleavedParams.Validate())
+
+	before := startTime.Add(-time.Second)
+	tests := []struct {
+		name      string
+		params    Params
+		height    int64
+		blockTime time.Time
+		index     int64
+		inflation sdk.Dec
+	}{
+		{"no height segment started", heightParams, 99, startTime, -1, heightParams.Inflation},
+		{"first segment", heightParams, 100, before, 0, sdk.NewDecWithPrec(8, 2)},
+		{"halving", heightParams, 200, before, 1, sdk.NewDecWithPrec(4, 2)},
+		{"second halving", heightParams, 300, startTime, 2, sdk.NewDecWithPrec(2, 2)},
+		{"no time segment started", timeParams, 300, before, -1, timeParams.Inflation},
+		{"time based halving", timeParams, 1, startTime, 0, timeParams.Inflation.QuoInt64(2)},
+		{"last segment", timeParams, 1, startTime.Add(time.Hour), 1, sdk.NewDecWithPrec(1, 2)},
+	}
+	for _, tc := range tests {
+		params := tc.params
+		active := params.ActiveInflationSegment(tc.height, tc.blockTime)
+		require.Equal(t, tc.index, active.Index, tc.name)
+		require.Equal(t, tc.inflation, active.Inflation, tc.name)
+		if tc.index < 0 {
+			require.Nil(t, active.Segment, tc.name)
+		} else {
+			require.Equal(t, params.InflationSchedule[tc.index], *active.Segment, tc.name)
+		}
+		require.Equal(t, tc.inflation, params.WithScheduledInflation(tc.height, tc.blockTime).Inflation, tc.name)
+	}
+}
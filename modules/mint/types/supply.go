@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSupplyHeadroom returns the amount of the denom which can still be minted before the
+// given supply reaches the max supply
+func NewSupplyHeadroom(denom string, supply, maxSupply sdk.Int) SupplyHeadroom {
+	headroom := maxSupply.Sub(supply)
+	if headroom.IsNegative() {
+		headroom = sdk.ZeroInt()
+	}
+	return SupplyHeadroom{
+		Supply:    sdk.NewCoin(denom, supply),
+		MaxSupply: sdk.NewCoin(denom, maxSupply),
+		Headroom:  sdk.NewCoin(denom, headroom),
+	}
+}
+
+// CapProvision limits the provision to the headroom, and returns true if the max supply is
+// reached by minting the returned provision
+func (h SupplyHeadroom) CapProvision(provision sdk.Coin) (sdk.Coin, bool) {
+	if provision.Amount.LT(h.Headroom.Amount) {
+		return provision, false
+	}
+	return h.Headroom, true
+}
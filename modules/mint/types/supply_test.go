@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSupplyHeadroom(t *testing.T) {
+	tests := []struct {
+		name         string
+		supply       int64
+		provision    int64
+		expHeadroom  int64
+		expProvision int64
+		expReached   bool
+	}{
+		{"below the cap", 500, 100, 500, 100, false},
+		{"reaching the cap", 900, 100, 100, 100, true},
+		{"crossing the cap", 950, 100, 50, 50, true},
+		{"at the cap", 1000, 100, 0, 0, true},
+		{"above the cap", 1200, 100, 0, 0, true},
+	}
+	for _, tc := range tests {
+		headroom := NewSupplyHeadroom(sdk.DefaultBondDenom, sdk.NewInt(tc.supply), sdk.NewInt(1000))
+		require.True(t, sdk.NewInt(tc.expHeadroom).Equal(headroom.Headroom.Amount), tc.name)
+
+		provision, reached := headroom.CapProvision(sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.provision))
+		require.True(t, sdk.NewInt(tc.expProvision).Equal(provision.Amount), tc.name)
+		require.Equal(t, tc.expReached, reached, tc.name)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -32,6 +33,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	guardiantypes "github.com/irisnet/irishub/modules/guardian/types"
 )
 
 // DefaultConsensusParams defines the default Tendermint consensus params used in
@@ -53,13 +56,31 @@ var DefaultConsensusParams = &abci.ConsensusParams{
 	},
 }
 
+// GenesisSuper is the genesis super of the genesis state used by the test helpers
+var GenesisSuper = sdk.AccAddress(crypto.AddressHash([]byte("genesis_super")))
+
+// NewTestGenesisState generates the default state for the application with a genesis super,
+// which the guardian genesis requires.
+func NewTestGenesisState() GenesisState {
+	encCfg := MakeEncodingConfig()
+	genesisState := NewDefaultGenesisState()
+
+	guardianGenState := guardiantypes.DefaultGenesisState()
+	guardianGenState.Supers = append(
+		guardianGenState.Supers,
+		guardiantypes.NewSuper("genesis", guardiantypes.Genesis, GenesisSuper, GenesisSuper),
+	)
+	genesisState[guardiantypes.ModuleName] = encCfg.Marshaler.MustMarshalJSON(guardianGenState)
+	return genesisState
+}
+
 // Setup initializes a new SimApp. A Nop logger is set in SimApp.
 func Setup(isCheckTx bool) *SimApp {
 	db := dbm.NewMemDB()
 	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, MakeEncodingConfig(), EmptyAppOptions{})
 	if !isCheckTx {
 		// init chain must be called to stop deliverState from being nil
-		genesisState := NewDefaultGenesisState()
+		genesisState := NewTestGenesisState()
 		stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 		if err != nil {
 			panic(err)
@@ -86,7 +107,7 @@ func NewConfig() network.Config {
 	cfg.LegacyAmino = encCfg.Amino
 	cfg.InterfaceRegistry = encCfg.InterfaceRegistry
 	cfg.AppConstructor = SimAppConstructor
-	cfg.GenesisState = NewDefaultGenesisState()
+	cfg.GenesisState = NewTestGenesisState()
 	return cfg
 }
 
@@ -107,7 +128,7 @@ func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs
 	db := dbm.NewMemDB()
 	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 5, MakeEncodingConfig(), EmptyAppOptions{})
 
-	genesisState := NewDefaultGenesisState()
+	genesisState := NewTestGenesisState()
 
 	// set genesis accounts
 	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
@@ -189,7 +210,7 @@ func SetupWithGenesisAccounts(genAccs []authtypes.GenesisAccount, balances ...ba
 	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeEncodingConfig(), EmptyAppOptions{})
 
 	// initialize the chain with the passed in genesis accounts
-	genesisState := NewDefaultGenesisState()
+	genesisState := NewTestGenesisState()
 
 	authGenesis := authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs)
 	genesisState[authtypes.ModuleName] = app.AppCodec().MustMarshalJSON(authGenesis)